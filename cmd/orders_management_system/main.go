@@ -2,25 +2,49 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
-	grpc_opentracing "github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/consumers"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/audit_storage"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/inbox_storage"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/orders_storage"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/outbox_storage"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/promotions_storage"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/webhooks_storage"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/server"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/business_rules"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/catalog"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/delivery_service"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/payments"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/pricing"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/services/warehouses_management_system"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/audit"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/eventbus"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+	middleware_auth "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/auth"
 	middleware_errors "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/errors"
 	middleware_logging "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/logging"
 	middleware_recovery "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/recovery"
 	middleware_tracing "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/tracing"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/scheduler"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/postgres"
-	jaeger_tracing "github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 )
@@ -32,10 +56,26 @@ func main() {
 	)
 	defer cancel()
 
-	logger.SetLevel(zapcore.InfoLevel)
+	logLevel, err := zapcore.ParseLevel(envOrDefault("LOG_LEVEL", "info"))
+	if err != nil {
+		logger.FatalKV(ctx, "invalid LOG_LEVEL", "error", err.Error())
+	}
+	logger.SetLevel(logLevel)
+	// level is changed at runtime with SIGUSR1 (debug on/off) or admin SetLogLevel RPC
+	logger.ToggleDebugOnSignal(ctx, syscall.SIGUSR1)
+
+	auditSink, err := newAuditSink(os.Getenv("AUDIT_LOG_FILE"))
+	if err != nil {
+		logger.FatalKV(ctx, "can't open audit log", "error", err.Error())
+	}
+	logger.SetAuditLogger(logger.NewAudit(auditSink))
 
 	logger.Info(ctx, "start app init")
-	if err := jaeger_tracing.Init("orders-management-system"); err != nil {
+	tracingConfig, err := newTracingConfig("orders-management-system")
+	if err != nil {
+		logger.FatalKV(ctx, "invalid tracing config", "error", err.Error())
+	}
+	if err = tracing.Init(ctx, tracingConfig); err != nil {
 		logger.Fatal(ctx, err)
 	}
 
@@ -55,30 +95,143 @@ func main() {
 
 	storage := orders_storage.New(txManager)
 
-	wmsClient := warehouses_management_system.NewClient()
+	promotionsStorage := promotions_storage.New(txManager)
+
+	// fakes of external services are used only when asked explicitly, otherwise their urls are required
+	useFakes := os.Getenv("USE_FAKES") == "true"
+
+	wmsClient, err := newWarehouseManagementSystem(os.Getenv("WMS_URL"), useFakes)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create warehouse management system client", "error", err.Error())
+	}
+
+	catalogClient, err := newCatalog(os.Getenv("CATALOG_SERVICE_URL"), useFakes)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create catalog client", "error", err.Error())
+	}
+
+	deliveryService, err := newDeliveryService(os.Getenv("DELIVERY_SERVICE_URL"), useFakes)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create delivery service client", "error", err.Error())
+	}
+
+	pricingClient, err := newPricing(os.Getenv("PRICING_SERVICE_URL"), useFakes)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create pricing client", "error", err.Error())
+	}
+
+	paymentsClient, err := newPayments(os.Getenv("PAYMENTS_SERVICE_URL"), useFakes)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create payments client", "error", err.Error())
+	}
+
+	businessRules, err := newBusinessRules(os.Getenv("BUSINESS_RULES_FILE"))
+	if err != nil {
+		logger.FatalKV(ctx, "can't load business rules", "error", err.Error())
+	}
+
+	paymentTTL, err := durationFromEnv("PAYMENT_TTL", 15*time.Minute)
+	if err != nil {
+		logger.FatalKV(ctx, "invalid payment ttl", "error", err.Error())
+	}
+
+	auditLog := audit.New(audit_storage.New(txManager), txManager)
+
+	orderEvents := eventbus.New(txManager, func(event models.OrderEvent) models.OrderID { return event.OrderID })
 
-	omsUsecase := orders_management_system.NewUsecase(orders_management_system.Deps{ // Dependency injection
+	omsUsecase := orders_management_system.NewUsecase(orders_management_system.Config{
+		PaymentTTL: paymentTTL,
+	}, orders_management_system.Deps{ // Dependency injection
 		WarehouseManagementSystem: wmsClient,
+		Catalog:                   catalogClient,
+		DeliveryService:           deliveryService,
+		Pricing:                   pricingClient,
+		Payments:                  paymentsClient,
 		OrdersStorage:             storage,
+		PromotionsStorage:         promotionsStorage,
 		TransactionManager:        txManager,
+		BusinessRules:             businessRules,
+		OrderEventBus:             orderEvents,
+		AuditLog:                  auditLog,
 	})
 
+	// admin methods are disabled until admin token is set
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	if adminToken == "" {
+		logger.Warn(ctx, "ADMIN_TOKEN is not set, admin methods are disabled")
+	}
+
 	config := server.Config{
 		GRPCPort:        os.Getenv("GRPC_PORT"),
 		GRPCGatewayPort: os.Getenv("HTTP_PORT"),
 		ChainUnaryInterceptors: []grpc.UnaryServerInterceptor{
-			grpc_opentracing.OpenTracingServerInterceptor(opentracing.GlobalTracer(), grpc_opentracing.LogPayloads()),
 			middleware_logging.LogErrorUnaryInterceptor(),
-			middleware_tracing.DebugOpenTracingUnaryServerInterceptor(true, true),
+			middleware_tracing.DebugTracingUnaryServerInterceptor(true, true),
 			middleware_recovery.RecoverUnaryInterceptor(),
+			middleware_auth.AdminUnaryInterceptor(adminToken),
+			audit.ActorUnaryInterceptor(),
 		},
 		UnaryInterceptors: []grpc.UnaryServerInterceptor{
 			middleware_errors.ErrorsUnaryInterceptor(),
 		},
+		ChainStreamInterceptors: []grpc.StreamServerInterceptor{
+			middleware_logging.LogErrorStreamInterceptor(),
+			middleware_tracing.DebugTracingStreamServerInterceptor(true, true),
+			middleware_recovery.RecoverStreamInterceptor(),
+			middleware_errors.ErrorsStreamInterceptor(),
+		},
+		GatewayUnaryInterceptors: []grpc.UnaryServerInterceptor{
+			middleware_logging.LogHTTPUnaryInterceptor(),
+			middleware_recovery.RecoverUnaryInterceptor(),
+			middleware_auth.AdminUnaryInterceptor(adminToken),
+			audit.ActorUnaryInterceptor(),
+		},
+		StatsHandler: otelgrpc.NewServerHandler(),
+	}
+
+	jobs := scheduler.New(scheduler.NewPostgresElector(pool, "orders-management-system.scheduler"))
+	if err = registerJobs(jobs, omsUsecase); err != nil {
+		logger.FatalKV(ctx, "can't register scheduled jobs", "error", err.Error())
+	}
+	jobs.Start(ctx)
+
+	subscriber, err := newSubscriber(os.Getenv("INBOX_FILE"))
+	if err != nil {
+		logger.FatalKV(ctx, "can't create inbox subscriber", "error", err.Error())
 	}
+	consumer := inbox.NewConsumer(subscriber, inbox_storage.New(txManager), txManager)
+	consumers.Register(consumer, omsUsecase)
+	consumer.Start(ctx)
+
+	outboxStorage := outbox_storage.New(txManager)
+	outboxListener := outbox.NewPostgresListener(pool, outbox.NotifyChannel)
+	outboxListener.Start(ctx)
+	cloudEventsSource := envOrDefault("OUTBOX_CLOUDEVENTS_SOURCE", "/orders-management-system")
+	outboxPublisher, err := newOutboxPublisher(os.Getenv("OUTBOX_PUBLISH_URL"), outbox.CloudEventsConfig{
+		Source: cloudEventsSource,
+		Mode:   outbox.ContentMode(os.Getenv("OUTBOX_CLOUDEVENTS_MODE")),
+	})
+	if err != nil {
+		logger.FatalKV(ctx, "can't create outbox publisher", "error", err.Error())
+	}
+
+	webhooksStorage := webhooks_storage.New(txManager)
+	webhooksFanout, err := webhooks.NewFanout(webhooksStorage, cloudEventsSource)
+	if err != nil {
+		logger.FatalKV(ctx, "can't create webhooks fanout", "error", err.Error())
+	}
+	webhooks.NewDispatcher(webhooksStorage, txManager, &http.Client{Timeout: 10 * time.Second}).Start(ctx)
+
+	// deliveries are saved first: relay publishes at least once, fanout skips deliveries saved by previous attempts
+	outbox.NewRelay(outboxStorage, txManager, outbox.MultiPublisher{webhooksFanout, outboxPublisher},
+		outbox.WithWaker(outboxListener, 10*time.Second),
+	).Start(ctx)
 
 	srv, err := server.New(ctx, config, server.Deps{
-		OMSUsecase: omsUsecase,
+		OMSUsecase:  omsUsecase,
+		OutboxAdmin: outbox.NewAdmin(outboxStorage, txManager),
+		Webhooks:    webhooks.NewSubscriptions(webhooksStorage),
+		AuditLog:    auditLog,
 	})
 	if err != nil {
 		logger.Fatalf(ctx, "failed to create server: %v", err)
@@ -88,3 +241,175 @@ func main() {
 		logger.Errorf(ctx, "run: %v", err)
 	}
 }
+
+func newBusinessRules(path string) (*business_rules.Engine, error) {
+	if path == "" {
+		return business_rules.New(business_rules.Config{})
+	}
+	return business_rules.NewFromFile(path)
+}
+
+// errNoURL - url of external service is not set and fakes are not enabled
+var errNoURL = errors.New("url is not set, USE_FAKES=true enables fakes for local runs")
+
+// newWarehouseManagementSystem - returns WMS client, or fake with unlimited stocks when url is not set and fakes are enabled
+func newWarehouseManagementSystem(url string, useFakes bool) (orders_management_system.WarehouseManagementSystem, error) {
+	if url != "" {
+		return warehouses_management_system.NewClient(url, &http.Client{Timeout: 5 * time.Second}), nil
+	}
+	if !useFakes {
+		return nil, fmt.Errorf("WMS_URL: %w", errNoURL)
+	}
+	return warehouses_management_system.NewFake(), nil
+}
+
+// newDeliveryService - returns delivery service client, or in-memory fake when url is not set and fakes are enabled
+func newDeliveryService(url string, useFakes bool) (orders_management_system.DeliveryService, error) {
+	if url != "" {
+		return delivery_service.NewClient(url, &http.Client{Timeout: 5 * time.Second}), nil
+	}
+	if !useFakes {
+		return nil, fmt.Errorf("DELIVERY_SERVICE_URL: %w", errNoURL)
+	}
+
+	slots := delivery_service.DailySlots(time.Now(), 365)
+	return delivery_service.NewFake(
+		models.DeliveryVariant{ID: 1, WarehouseIDs: []models.WarehouseID{1, 2, 3}, Slots: slots},
+		models.DeliveryVariant{ID: 2, WarehouseIDs: []models.WarehouseID{1, 2, 3}, Slots: slots},
+	), nil
+}
+
+// newCatalog - returns catalog client, or in-memory fake when url is not set and fakes are enabled
+func newCatalog(url string, useFakes bool) (orders_management_system.Catalog, error) {
+	if url != "" {
+		return catalog.NewClient(url, &http.Client{Timeout: 5 * time.Second}), nil
+	}
+	if !useFakes {
+		return nil, fmt.Errorf("CATALOG_SERVICE_URL: %w", errNoURL)
+	}
+
+	skus := make([]models.CatalogSKU, 0, 10)
+	for id := models.SKUID(1); id <= 10; id++ {
+		skus = append(skus, models.CatalogSKU{
+			SKU: models.SKU{
+				ID:    id,
+				Name:  fmt.Sprintf("SKU %d", id),
+				Price: models.Money{CurrencyCode: "RUB", Units: int64(id) * 100},
+			},
+			Available: true,
+		})
+	}
+	return catalog.NewFake(skus...), nil
+}
+
+// newPricing - returns pricing client, or fake with flat delivery tariffs when url is not set and fakes are enabled
+func newPricing(url string, useFakes bool) (orders_management_system.Pricing, error) {
+	if url != "" {
+		return pricing.NewClient(url, &http.Client{Timeout: 5 * time.Second}), nil
+	}
+	if !useFakes {
+		return nil, fmt.Errorf("PRICING_SERVICE_URL: %w", errNoURL)
+	}
+	return pricing.NewFake(map[models.DeliveryVariantID]models.Money{
+		1: {CurrencyCode: "RUB", Units: 300},
+		2: {CurrencyCode: "RUB", Units: 0},
+	}), nil
+}
+
+// newPayments - returns payments client, or in-memory fake when url is not set and fakes are enabled
+func newPayments(url string, useFakes bool) (orders_management_system.Payments, error) {
+	if url != "" {
+		return payments.NewClient(url, &http.Client{Timeout: 5 * time.Second}), nil
+	}
+	if !useFakes {
+		return nil, fmt.Errorf("PAYMENTS_SERVICE_URL: %w", errNoURL)
+	}
+	return payments.NewFake("http://localhost:8080/pay/"), nil
+}
+
+// newSubscriber - returns subscriber reading JSON lines file, or in-memory one when path is not set
+func newSubscriber(path string) (inbox.Subscriber, error) {
+	if path == "" {
+		return inbox.NewMemorySubscriber(0), nil
+	}
+	return inbox.NewFileSubscriber(path)
+}
+
+// newOutboxPublisher - returns publisher POSTing CloudEvents to url, or one writing them to log when url is not set
+func newOutboxPublisher(url string, cfg outbox.CloudEventsConfig) (outbox.Publisher, error) {
+	encoder, err := outbox.NewCloudEventsEncoder(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		return outbox.LogPublisher{Encoder: encoder}, nil
+	}
+	return outbox.NewHTTPPublisher(url, &http.Client{Timeout: 5 * time.Second}, encoder), nil
+}
+
+// newAuditSink - audit records are appended to file, so that they are shipped and retained
+// separately from application logs, stdout is used when path is empty
+func newAuditSink(path string) (io.Writer, error) {
+	if path == "" {
+		return os.Stdout, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	closer.Add(func(context.Context) error { return f.Close() })
+
+	return f, nil
+}
+
+// registerJobs - registers periodic jobs, they run on a single replica at a time
+func registerJobs(s *scheduler.Scheduler, uc orders_management_system.UsecaseInterface) error {
+	return s.Register("cancel_expired_orders", envOrDefault("CANCEL_EXPIRED_ORDERS_SCHEDULE", "@every 30s"),
+		func(ctx context.Context) error {
+			cancelled, err := uc.CancelExpiredOrders(audit.WithActor(ctx, audit.ActorSystem))
+			if cancelled > 0 {
+				logger.InfoKV(ctx, "expired orders cancelled", "count", cancelled)
+			}
+			return err
+		},
+	)
+}
+
+// newTracingConfig - reads tracing settings from environment
+func newTracingConfig(serviceName string) (tracing.Config, error) {
+	cfg := tracing.Config{
+		ServiceName:    serviceName,
+		ServiceVersion: os.Getenv("SERVICE_VERSION"),
+		Environment:    os.Getenv("DEPLOYMENT_ENVIRONMENT"),
+		Exporter:       tracing.Exporter(envOrDefault("TRACING_EXPORTER", string(tracing.ExporterOTLPGRPC))),
+		Endpoint:       os.Getenv("TRACING_ENDPOINT"),
+		Insecure:       os.Getenv("TRACING_INSECURE") == "true",
+		SampleRatio:    1,
+	}
+
+	if value := os.Getenv("TRACING_SAMPLE_RATIO"); value != "" {
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return tracing.Config{}, fmt.Errorf("TRACING_SAMPLE_RATIO: %w", err)
+		}
+		cfg.SampleRatio = ratio
+	}
+
+	return cfg, nil
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func durationFromEnv(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return time.ParseDuration(value)
+}
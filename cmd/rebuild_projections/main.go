@@ -0,0 +1,79 @@
+// rebuild_projections - rebuilds orders projection from order_events log.
+//
+// Usage:
+//
+//	DB_DSN=postgresql://... rebuild_projections [-order-id <uuid>] [-batch-size 100]
+//
+// Without -order-id projections of all orders are rebuilt. Service may keep running meanwhile:
+// every order is rebuilt in its own transaction under lock of its row.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/orders_storage"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/projections"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/postgres"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+func main() {
+	var (
+		orderID   = flag.String("order-id", "", "rebuild projection of single order")
+		batchSize = flag.Uint64("batch-size", 100, "number of orders read from event log at once")
+	)
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(),
+		syscall.SIGINT,
+		syscall.SIGTERM,
+	)
+	defer cancel()
+
+	dsn := os.Getenv("DB_DSN")
+
+	pool, err := postgres.NewConnectionPool(ctx, dsn,
+		postgres.WithMaxConnIdleTime(time.Minute),
+		postgres.WithMaxConnectionsCount(2),
+		postgres.WithMinConnectionsCount(1),
+	)
+	if err != nil {
+		logger.FatalKV(ctx, "can't connect to database", "error", err.Error(), "dsn", dsn)
+	}
+	defer func() { _ = pool.Close() }()
+
+	txManager := transaction_manager.New(pool)
+	rebuilder := projections.NewRebuilder(orders_storage.New(txManager), txManager,
+		projections.WithBatchSize(*batchSize),
+	)
+
+	if *orderID != "" {
+		id, err := uuid.Parse(*orderID)
+		if err != nil {
+			logger.FatalKV(ctx, "invalid order id", "error", err.Error())
+		}
+		if err = rebuilder.RebuildOrder(ctx, models.OrderID(id)); err != nil {
+			logger.FatalKV(ctx, "can't rebuild order projection", "error", err.Error())
+		}
+		logger.InfoKV(ctx, "order projection rebuilt", "order_id", *orderID)
+		return
+	}
+
+	start := time.Now()
+	rebuilt, err := rebuilder.Rebuild(ctx)
+	if err != nil {
+		logger.ErrorKV(ctx, "some order projections are not rebuilt", "error", err.Error())
+	}
+	logger.InfoKV(ctx, "order projections rebuilt", "count", rebuilt, "duration", time.Since(start).String())
+	if err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,16 @@
+// Package consumers - handlers of inbound events
+package consumers
+
+import (
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+)
+
+const (
+	TopicPaymentSucceeded = "payments.payment_succeeded"
+)
+
+// Register - registers handlers of all topics consumed by the service
+func Register(c *inbox.Consumer, uc orders_management_system.UsecaseInterface) {
+	c.Handle(TopicPaymentSucceeded, PaymentSucceeded(uc))
+}
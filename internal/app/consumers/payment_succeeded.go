@@ -0,0 +1,45 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/audit"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+)
+
+type paymentSucceeded struct {
+	OrderID   string `json:"order_id"`
+	PaymentID string `json:"payment_id"`
+}
+
+// PaymentSucceeded - confirms payment of the order, inbound alternative of ConfirmPayment RPC
+func PaymentSucceeded(uc orders_management_system.UsecaseInterface) inbox.Handler {
+	return func(ctx context.Context, msg inbox.Message) error {
+		var event paymentSucceeded
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return inbox.Permanent(fmt.Errorf("invalid payload: %w", err))
+		}
+
+		orderID, err := uuid.Parse(event.OrderID)
+		if err != nil {
+			return inbox.Permanent(fmt.Errorf("invalid order_id: %w", err))
+		}
+
+		ctx = audit.WithActor(ctx, "inbox:"+TopicPaymentSucceeded)
+		_, err = uc.ConfirmPayment(ctx, models.OrderID(orderID), models.PaymentID(event.PaymentID), 0)
+		switch {
+		case errors.Is(err, models.ErrNotFound),
+			errors.Is(err, models.ErrPaymentMismatch),
+			errors.Is(err, models.ErrInvalidOrderStatus):
+			return inbox.Permanent(err)
+		default:
+			return err
+		}
+	}
+}
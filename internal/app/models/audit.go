@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// AuditAction - kind of order mutation recorded in audit log
+type AuditAction string
+
+const (
+	AuditActionCreateOrder        AuditAction = "create_order"
+	AuditActionUpdateOrder        AuditAction = "update_order"
+	AuditActionConfirmPayment     AuditAction = "confirm_payment"
+	AuditActionCancelExpiredOrder AuditAction = "cancel_expired_order"
+)
+
+// AuditRecord - order mutation: who changed which fields of the order, when and from where
+type AuditRecord struct {
+	// ID - increases with every record
+	ID      int64
+	OrderID OrderID
+	// Actor - caller identity, e.g. "user:42", or "system" for background jobs
+	Actor   string
+	Action  AuditAction
+	Changes []AuditChange
+	// ClientIP - address of the caller, empty for background jobs
+	ClientIP  string
+	RequestID string
+	CreatedAt time.Time
+}
+
+// AuditChange - values of the order field before and after mutation,
+// Before is empty for created order
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+type DeliveryVariant struct {
+	ID           DeliveryVariantID
+	WarehouseIDs []WarehouseID
+	Slots        []DeliverySlot
+}
+
+type DeliverySlot struct {
+	ID        DeliverySlotID
+	From      time.Time
+	To        time.Time
+	Available bool
+}
+
+// ServesWarehouse - reports whether delivery variant picks up orders from the warehouse
+func (v *DeliveryVariant) ServesWarehouse(id WarehouseID) bool {
+	for _, warehouseID := range v.WarehouseIDs {
+		if warehouseID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// SlotAt - returns available slot containing the date
+func (v *DeliveryVariant) SlotAt(date time.Time) (DeliverySlot, bool) {
+	for _, slot := range v.Slots {
+		if slot.Available && !date.Before(slot.From) && date.Before(slot.To) {
+			return slot, true
+		}
+	}
+	return DeliverySlot{}, false
+}
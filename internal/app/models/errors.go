@@ -4,5 +4,17 @@ import "errors"
 
 var (
 	ErrAlreadyExists = errors.New("already exists")
+	ErrNotFound      = errors.New("not found")
 	ErrUnimplemented = errors.New("unimplemented")
+
+	ErrDeliverySlotUnavailable = errors.New("delivery slot is unavailable")
+	ErrCurrencyMismatch        = errors.New("currency mismatch")
+	ErrAmountOverflow          = errors.New("amount overflow")
+	ErrInvalidOrderStatus      = errors.New("invalid order status")
+	ErrPaymentMismatch         = errors.New("payment does not match order")
+	ErrInvalidOrderEvents      = errors.New("invalid order events")
+	ErrVersionConflict         = errors.New("order was changed concurrently, version does not match")
+
+	ErrInvalidOutboxMessageStatus = errors.New("invalid outbox message status")
+	ErrWatchLagged                = errors.New("watcher fell behind, events are lost")
 )
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money - exact amount of money in currency, same representation as google.type.Money:
+// amount = Units + Nanos / 10^9, Units and Nanos have the same sign
+type Money struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+const nanosPerUnit = 1_000_000_000
+
+// Add - returns sum of amounts, zero amount without currency is compatible with any currency.
+// Fails when units of the sum do not fit into int64
+func (m Money) Add(o Money) (Money, error) {
+	currencyCode := m.CurrencyCode
+	switch {
+	case currencyCode == "" && m.IsZero():
+		currencyCode = o.CurrencyCode
+	case o.CurrencyCode == "" && o.IsZero():
+	case currencyCode != o.CurrencyCode:
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.CurrencyCode, o.CurrencyCode)
+	}
+
+	sum, ok := moneyFromNanos(currencyCode, new(big.Int).Add(m.nanos(), o.nanos()))
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s + %s", ErrAmountOverflow, m, o)
+	}
+	return sum, nil
+}
+
+// Multiply - returns amount multiplied by quantity, fails when units of the result do not fit into int64
+func (m Money) Multiply(quantity uint32) (Money, error) {
+	product, ok := moneyFromNanos(m.CurrencyCode, new(big.Int).Mul(m.nanos(), big.NewInt(int64(quantity))))
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s * %d", ErrAmountOverflow, m, quantity)
+	}
+	return product, nil
+}
+
+// nanos - returns amount in nanos
+func (m Money) nanos() *big.Int {
+	nanos := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(nanosPerUnit))
+	return nanos.Add(nanos, big.NewInt(int64(m.Nanos)))
+}
+
+// moneyFromNanos - returns amount of nanos, false when its units do not fit into int64.
+// Units and nanos of the result have the same sign as quotient and remainder are truncated towards zero
+func moneyFromNanos(currencyCode string, nanos *big.Int) (Money, bool) {
+	units, rem := new(big.Int).QuoRem(nanos, big.NewInt(nanosPerUnit), new(big.Int))
+	if !units.IsInt64() {
+		return Money{}, false
+	}
+	return Money{
+		CurrencyCode: currencyCode,
+		Units:        units.Int64(),
+		Nanos:        int32(rem.Int64()),
+	}, true
+}
+
+// Negate - returns amount with opposite sign
+func (m Money) Negate() Money {
+	return Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        -m.Units,
+		Nanos:        -m.Nanos,
+	}
+}
+
+// Percent - returns share of amount in basis points (1/100 of percent) rounded down to nanos
+func (m Money) Percent(basisPoints uint32) Money {
+	nanos := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(nanosPerUnit))
+	nanos.Add(nanos, big.NewInt(int64(m.Nanos)))
+	nanos.Mul(nanos, big.NewInt(int64(basisPoints)))
+	nanos.Quo(nanos, big.NewInt(10_000))
+
+	units, rem := new(big.Int).QuoRem(nanos, big.NewInt(nanosPerUnit), new(big.Int))
+	return Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        units.Int64(),
+		Nanos:        int32(rem.Int64()),
+	}
+}
+
+// Compare - returns -1, 0 or +1 when amount is less, equal or greater than o, currencies are not compared
+func (m Money) Compare(o Money) int {
+	switch {
+	case m.Units < o.Units:
+		return -1
+	case m.Units > o.Units:
+		return 1
+	case m.Nanos < o.Nanos:
+		return -1
+	case m.Nanos > o.Nanos:
+		return 1
+	}
+	return 0
+}
+
+// IsZero - reports whether amount is zero
+func (m Money) IsZero() bool {
+	return m.Units == 0 && m.Nanos == 0
+}
+
+// String - returns decimal representation of amount, e.g. "12.500000000"
+func (m Money) String() string {
+	units, nanos := m.Units, int64(m.Nanos)
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+	return fmt.Sprintf("%s%d.%09d", sign, units, nanos)
+}
+
+// ParseMoney - parses decimal amount with at most 9 fractional digits
+func ParseMoney(currencyCode, amount string) (Money, error) {
+	s := strings.TrimSpace(amount)
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" || len(fracPart) > 9 {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	fracPart += strings.Repeat("0", 9-len(fracPart))
+
+	if strings.ContainsAny(intPart+fracPart, "+-") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	nanos, err := strconv.ParseInt(fracPart, 10, 32)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+
+	if negative {
+		units, nanos = -units, -nanos
+	}
+
+	return Money{
+		CurrencyCode: currencyCode,
+		Units:        units,
+		Nanos:        int32(nanos),
+	}, nil
+}
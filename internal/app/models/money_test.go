@@ -0,0 +1,136 @@
+//go:build test
+
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    Money
+		want    Money
+		wantErr error
+	}{
+		{
+			name: "Test 1. Carry nanos.",
+			a:    Money{CurrencyCode: "RUB", Units: 1, Nanos: 600_000_000},
+			b:    Money{CurrencyCode: "RUB", Units: 2, Nanos: 700_000_000},
+			want: Money{CurrencyCode: "RUB", Units: 4, Nanos: 300_000_000},
+		},
+		{
+			name: "Test 2. Zero without currency.",
+			a:    Money{},
+			b:    Money{CurrencyCode: "RUB", Units: 2},
+			want: Money{CurrencyCode: "RUB", Units: 2},
+		},
+		{
+			name: "Test 3. Different signs.",
+			a:    Money{CurrencyCode: "RUB", Units: 1, Nanos: 0},
+			b:    Money{CurrencyCode: "RUB", Units: -1, Nanos: -500_000_000},
+			want: Money{CurrencyCode: "RUB", Units: 0, Nanos: -500_000_000},
+		},
+		{
+			name:    "Test 4. Currency mismatch.",
+			a:       Money{CurrencyCode: "RUB", Units: 1},
+			b:       Money{CurrencyCode: "USD", Units: 1},
+			wantErr: ErrCurrencyMismatch,
+		},
+		{
+			name:    "Test 5. Units overflow.",
+			a:       Money{CurrencyCode: "RUB", Units: math.MaxInt64},
+			b:       Money{CurrencyCode: "RUB", Units: 1},
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name:    "Test 6. Units overflow by carry of nanos.",
+			a:       Money{CurrencyCode: "RUB", Units: math.MaxInt64, Nanos: 600_000_000},
+			b:       Money{CurrencyCode: "RUB", Nanos: 500_000_000},
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name:    "Test 7. Negative units overflow.",
+			a:       Money{CurrencyCode: "RUB", Units: math.MinInt64},
+			b:       Money{CurrencyCode: "RUB", Units: -1},
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name: "Test 8. Maximum amount.",
+			a:    Money{CurrencyCode: "RUB", Units: math.MaxInt64 - 1, Nanos: 600_000_000},
+			b:    Money{CurrencyCode: "RUB", Nanos: 500_000_000},
+			want: Money{CurrencyCode: "RUB", Units: math.MaxInt64, Nanos: 100_000_000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Add(tt.b)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Multiply(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Money
+		quantity uint32
+		want     Money
+		wantErr  error
+	}{
+		{
+			name:     "Test 1. Carry nanos.",
+			m:        Money{CurrencyCode: "RUB", Units: 10, Nanos: 990_000_000},
+			quantity: 3,
+			want:     Money{CurrencyCode: "RUB", Units: 32, Nanos: 970_000_000},
+		},
+		{
+			name:     "Test 2. Negative amount.",
+			m:        Money{CurrencyCode: "RUB", Units: -1, Nanos: -500_000_000},
+			quantity: 3,
+			want:     Money{CurrencyCode: "RUB", Units: -4, Nanos: -500_000_000},
+		},
+		{
+			name:     "Test 3. Units overflow.",
+			m:        Money{CurrencyCode: "RUB", Units: math.MaxInt64 / 2},
+			quantity: 3,
+			wantErr:  ErrAmountOverflow,
+		},
+		{
+			name:     "Test 4. Overflow by carried nanos.",
+			m:        Money{CurrencyCode: "RUB", Units: math.MaxInt64 / 3, Nanos: 999_999_999},
+			quantity: 3,
+			wantErr:  ErrAmountOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Multiply(tt.quantity)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	got, err := ParseMoney("RUB", "-12.05")
+	require.NoError(t, err)
+	assert.Equal(t, Money{CurrencyCode: "RUB", Units: -12, Nanos: -50_000_000}, got)
+	assert.Equal(t, "-12.050000000", got.String())
+
+	_, err = ParseMoney("RUB", "1.2.3")
+	assert.Error(t, err)
+}
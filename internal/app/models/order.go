@@ -5,19 +5,84 @@ import (
 )
 
 type Order struct {
-	ID     OrderID
-	UserID UserID
-	Items  []Item
+	ID OrderID
+	// Version - version of the last event of the order, it is incremented on every change
+	Version int64
+	UserID  UserID
+	Status  OrderStatus
+	Items   []Item
 	DeliveryOrderInfo
+	OrderPricing
+	PaymentOrderInfo
+}
+
+type OrderStatus string
+
+const (
+	OrderStatusAwaitingPayment OrderStatus = "awaiting_payment"
+	OrderStatusPaid            OrderStatus = "paid"
+	OrderStatusCancelled       OrderStatus = "cancelled"
+)
+
+// IsUpdatable - reports whether items and delivery of the order can be changed,
+// paid order is handed over to assembly and can not be changed anymore
+func (s OrderStatus) IsUpdatable() bool {
+	return s == OrderStatusAwaitingPayment
+}
+
+// Fields of the order which can be changed after creation, named as paths of API field mask
+const (
+	OrderFieldItems             = "items"
+	OrderFieldDeliveryInfo      = "delivery_info"
+	OrderFieldDeliveryVariantID = "delivery_info.delivery_variant_id"
+	OrderFieldDeliveryDate      = "delivery_info.delivery_date"
+)
+
+// OrderEventType - type of order event published through outbox
+type OrderEventType string
+
+const (
+	OrderEventCreated   OrderEventType = "order.created"
+	OrderEventPaid      OrderEventType = "order.paid"
+	OrderEventCancelled OrderEventType = "order.cancelled"
+	// OrderEventUpdated - items or delivery of the order are changed by customer
+	OrderEventUpdated OrderEventType = "order.updated"
+)
+
+// OrderPricing - monetary summary of the order, all amounts are in CurrencyCode:
+// Total = Subtotal - Discount + DeliveryCost
+type OrderPricing struct {
+	CurrencyCode string
+	Subtotal     Money
+	DeliveryCost Money
+	Discount     Money
+	PromoCode    string
+	Total        Money
 }
 
 type DeliveryOrderInfo struct {
 	DeliveryVariantID DeliveryVariantID
 	DeliveryDate      time.Time
+	DeliverySlotID    DeliverySlotID
+}
+
+// PaymentOrderInfo - payment intent of the order, unpaid order is cancelled after PaymentExpiresAt
+type PaymentOrderInfo struct {
+	PaymentID        PaymentID
+	PaymentURL       string
+	PaymentExpiresAt time.Time
 }
 
 type Item struct {
 	SKU         SKU
 	Quantity    uint32
 	WarehouseID WarehouseID
+	UnitPrice   Money
+	Total       Money
+}
+
+// PriceQuote - prices of the order calculated by pricing
+type PriceQuote struct {
+	UnitPrices   map[SKUID]Money
+	DeliveryCost Money
 }
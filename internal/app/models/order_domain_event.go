@@ -0,0 +1,118 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderDomainEvent - change of the order in append-only order_events log,
+// orders table is projection of the log and can be rebuilt by replaying it
+type OrderDomainEvent struct {
+	OrderID OrderID
+	// Version - number of the event in order stream starting from 1
+	Version    int64
+	Type       OrderEventType
+	OccurredAt time.Time
+	// Data - change made by the event, its type depends on Type
+	Data OrderEventData
+}
+
+// OrderEventData - payload of order domain event
+type OrderEventData interface {
+	eventType() OrderEventType
+	apply(order *Order)
+}
+
+// OrderCreatedData - initial state of the order
+type OrderCreatedData struct {
+	Order Order
+}
+
+// OrderUpdatedData - new items and delivery of the order changed by customer, pricing and
+// payment intent follow them. Fields - changed fields of the order, see OrderField* constants
+type OrderUpdatedData struct {
+	Fields   []string
+	Items    []Item
+	Delivery DeliveryOrderInfo
+	Pricing  OrderPricing
+	Payment  PaymentOrderInfo
+}
+
+// OrderPaidData - payment of the order is confirmed
+type OrderPaidData struct {
+	PaymentID PaymentID
+}
+
+// OrderCancelledData - order is cancelled
+type OrderCancelledData struct {
+	Reason string
+}
+
+// CancelReasonPaymentExpired - order was not paid in time
+const CancelReasonPaymentExpired = "payment_expired"
+
+func (OrderCreatedData) eventType() OrderEventType   { return OrderEventCreated }
+func (OrderUpdatedData) eventType() OrderEventType   { return OrderEventUpdated }
+func (OrderPaidData) eventType() OrderEventType      { return OrderEventPaid }
+func (OrderCancelledData) eventType() OrderEventType { return OrderEventCancelled }
+
+func (d OrderCreatedData) apply(order *Order) {
+	*order = d.Order
+	order.Items = append([]Item(nil), d.Order.Items...)
+}
+
+func (d OrderUpdatedData) apply(order *Order) {
+	order.Items = append([]Item(nil), d.Items...)
+	order.DeliveryOrderInfo = d.Delivery
+	order.OrderPricing = d.Pricing
+	order.PaymentOrderInfo = d.Payment
+}
+
+func (d OrderPaidData) apply(order *Order) {
+	order.Status = OrderStatusPaid
+	order.PaymentID = d.PaymentID
+}
+
+func (d OrderCancelledData) apply(order *Order) {
+	order.Status = OrderStatusCancelled
+}
+
+// NewOrderDomainEvent - returns next event of the order with data
+func NewOrderDomainEvent(order *Order, data OrderEventData) OrderDomainEvent {
+	return OrderDomainEvent{
+		OrderID:    order.ID,
+		Version:    order.Version + 1,
+		Type:       data.eventType(),
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+// Apply - changes order as event describes, order gets version of the event
+func (o *Order) Apply(event OrderDomainEvent) {
+	event.Data.apply(o)
+	o.Version = event.Version
+}
+
+// ReplayOrder - returns state of the order after events, events must be the whole stream
+// of one order in order of versions starting from creation
+func ReplayOrder(events []OrderDomainEvent) (*Order, error) {
+	if len(events) == 0 {
+		return nil, ErrNotFound
+	}
+	if _, ok := events[0].Data.(OrderCreatedData); !ok {
+		return nil, fmt.Errorf("order %s: first event is %s: %w", events[0].OrderID, events[0].Type, ErrInvalidOrderEvents)
+	}
+
+	order := &Order{}
+	for i, event := range events {
+		if event.OrderID != events[0].OrderID || event.Version != int64(i+1) {
+			return nil, fmt.Errorf("order %s: event %s has version %d, want %d: %w",
+				events[0].OrderID, event.Type, event.Version, i+1, ErrInvalidOrderEvents)
+		}
+		order.Apply(event)
+	}
+	order.ID = events[0].OrderID
+
+	return order, nil
+}
@@ -0,0 +1,123 @@
+//go:build test
+
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayOrder(t *testing.T) {
+	orderID := OrderID(uuid.New())
+	created := Order{
+		ID:     orderID,
+		UserID: 1,
+		Status: OrderStatusAwaitingPayment,
+		Items: []Item{
+			{SKU: SKU{ID: 1}, Quantity: 2, WarehouseID: 3},
+		},
+		PaymentOrderInfo: PaymentOrderInfo{PaymentID: "pi_1"},
+	}
+	event := func(version int64, data OrderEventData) OrderDomainEvent {
+		e := NewOrderDomainEvent(&Order{ID: orderID}, data)
+		e.Version = version
+		return e
+	}
+
+	tests := []struct {
+		name    string
+		events  []OrderDomainEvent
+		want    func() *Order
+		wantErr error
+	}{
+		{
+			name: "Test 1. Positive. Created, items updated and paid.",
+			events: []OrderDomainEvent{
+				event(1, OrderCreatedData{Order: created}),
+				event(2, OrderUpdatedData{
+					Fields:  []string{OrderFieldItems},
+					Items:   []Item{{SKU: SKU{ID: 1}, Quantity: 5, WarehouseID: 3}},
+					Pricing: OrderPricing{CurrencyCode: "RUB", Total: Money{CurrencyCode: "RUB", Units: 10}},
+					Payment: PaymentOrderInfo{PaymentID: "pi_1"},
+				}),
+				event(3, OrderPaidData{PaymentID: "pi_1"}),
+			},
+			want: func() *Order {
+				order := created
+				order.Items = []Item{{SKU: SKU{ID: 1}, Quantity: 5, WarehouseID: 3}}
+				order.OrderPricing = OrderPricing{CurrencyCode: "RUB", Total: Money{CurrencyCode: "RUB", Units: 10}}
+				order.Status = OrderStatusPaid
+				order.Version = 3
+				return &order
+			},
+		},
+		{
+			name: "Test 2. Positive. Cancelled.",
+			events: []OrderDomainEvent{
+				event(1, OrderCreatedData{Order: created}),
+				event(2, OrderCancelledData{Reason: CancelReasonPaymentExpired}),
+			},
+			want: func() *Order {
+				order := created
+				order.Status = OrderStatusCancelled
+				order.Version = 2
+				return &order
+			},
+		},
+		{
+			name:    "Test 3. Negative. No events.",
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "Test 4. Negative. Stream does not start with creation.",
+			events: []OrderDomainEvent{
+				event(1, OrderPaidData{PaymentID: "pi_1"}),
+			},
+			wantErr: ErrInvalidOrderEvents,
+		},
+		{
+			name: "Test 5. Negative. Gap in versions.",
+			events: []OrderDomainEvent{
+				event(1, OrderCreatedData{Order: created}),
+				event(3, OrderPaidData{PaymentID: "pi_1"}),
+			},
+			wantErr: ErrInvalidOrderEvents,
+		},
+		{
+			name: "Test 6. Positive. Updated by customer.",
+			events: []OrderDomainEvent{
+				event(1, OrderCreatedData{Order: created}),
+				event(2, OrderUpdatedData{
+					Fields:   []string{OrderFieldItems, OrderFieldDeliveryVariantID},
+					Items:    []Item{{SKU: SKU{ID: 1}, Quantity: 1, WarehouseID: 3}},
+					Delivery: DeliveryOrderInfo{DeliveryVariantID: 2, DeliverySlotID: 4},
+					Pricing:  OrderPricing{CurrencyCode: "RUB", Total: Money{CurrencyCode: "RUB", Units: 5}},
+					Payment:  PaymentOrderInfo{PaymentID: "pi_2"},
+				}),
+			},
+			want: func() *Order {
+				order := created
+				order.Items = []Item{{SKU: SKU{ID: 1}, Quantity: 1, WarehouseID: 3}}
+				order.DeliveryOrderInfo = DeliveryOrderInfo{DeliveryVariantID: 2, DeliverySlotID: 4}
+				order.OrderPricing = OrderPricing{CurrencyCode: "RUB", Total: Money{CurrencyCode: "RUB", Units: 5}}
+				order.PaymentOrderInfo = PaymentOrderInfo{PaymentID: "pi_2"}
+				order.Version = 2
+				return &order
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReplayOrder(tt.events)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want(), got)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// OrderEvent - order status transition
+type OrderEvent struct {
+	// ID - id of the transition in order status history, increases with every transition
+	ID      int64
+	OrderID OrderID
+	// Type - empty for the snapshot of the current state
+	Type       OrderEventType
+	Status     OrderStatus
+	OccurredAt time.Time
+}
+
+// NewOrderEvent - returns event of order transition to its current status
+func NewOrderEvent(order *Order, eventType OrderEventType) OrderEvent {
+	return OrderEvent{
+		OrderID:    order.ID,
+		Type:       eventType,
+		Status:     order.Status,
+		OccurredAt: time.Now(),
+	}
+}
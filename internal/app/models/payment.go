@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type PaymentID string
+
+// PaymentIntent - intention to collect order total created in payments service
+type PaymentIntent struct {
+	ID      PaymentID
+	OrderID OrderID
+	Amount  Money
+	// ConfirmationURL - page where user completes the payment
+	ConfirmationURL string
+	ExpiresAt       time.Time
+}
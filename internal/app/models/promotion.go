@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+type PromoCampaignID int64
+
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage"
+	DiscountTypeFixed      DiscountType = "fixed"
+)
+
+// PromoCampaign - discount campaign activated by promo code
+type PromoCampaign struct {
+	ID           PromoCampaignID
+	Code         string
+	DiscountType DiscountType
+	// PercentOff - discount in basis points (1/100 of percent) for percentage campaigns
+	PercentOff uint32
+	// AmountOff - discount amount for fixed campaigns
+	AmountOff Money
+	ValidFrom time.Time
+	ValidTo   time.Time
+	// UsageLimitPerUser - max redemptions by a single user, 0 - unlimited
+	UsageLimitPerUser uint32
+}
+
+// IsActive - reports whether campaign is valid at the moment
+func (c *PromoCampaign) IsActive(at time.Time) bool {
+	return !at.Before(c.ValidFrom) && at.Before(c.ValidTo)
+}
+
+// Discount - returns discount of campaign for subtotal, discount never exceeds subtotal
+func (c *PromoCampaign) Discount(subtotal Money) (Money, error) {
+	var discount Money
+	switch c.DiscountType {
+	case DiscountTypePercentage:
+		discount = subtotal.Percent(c.PercentOff)
+	case DiscountTypeFixed:
+		if c.AmountOff.CurrencyCode != subtotal.CurrencyCode {
+			return Money{}, ErrCurrencyMismatch
+		}
+		discount = c.AmountOff
+	}
+
+	if discount.Compare(subtotal) > 0 {
+		discount = subtotal
+	}
+
+	return discount, nil
+}
+
+// PromoRedemption - usage of promo campaign by order
+type PromoRedemption struct {
+	CampaignID PromoCampaignID
+	UserID     UserID
+	OrderID    OrderID
+	Discount   Money
+}
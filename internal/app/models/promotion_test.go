@@ -0,0 +1,56 @@
+//go:build test
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoCampaign_Discount(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign PromoCampaign
+		subtotal Money
+		want     Money
+		wantErr  error
+	}{
+		{
+			name:     "Test 1. Percentage is rounded down to nanos.",
+			campaign: PromoCampaign{DiscountType: DiscountTypePercentage, PercentOff: 1250},
+			subtotal: Money{CurrencyCode: "RUB", Units: 0, Nanos: 7},
+			want:     Money{CurrencyCode: "RUB", Units: 0, Nanos: 0},
+		},
+		{
+			name:     "Test 2. Percentage.",
+			campaign: PromoCampaign{DiscountType: DiscountTypePercentage, PercentOff: 1000},
+			subtotal: Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+			want:     Money{CurrencyCode: "RUB", Units: 3, Nanos: 150_000_000},
+		},
+		{
+			name:     "Test 3. Fixed discount is capped at subtotal.",
+			campaign: PromoCampaign{DiscountType: DiscountTypeFixed, AmountOff: Money{CurrencyCode: "RUB", Units: 500}},
+			subtotal: Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+			want:     Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+		},
+		{
+			name:     "Test 4. Fixed discount in other currency.",
+			campaign: PromoCampaign{DiscountType: DiscountTypeFixed, AmountOff: Money{CurrencyCode: "USD", Units: 5}},
+			subtotal: Money{CurrencyCode: "RUB", Units: 31},
+			wantErr:  ErrCurrencyMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.campaign.Discount(tt.subtotal)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
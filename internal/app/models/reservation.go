@@ -0,0 +1,15 @@
+package models
+
+// ReservationRelease - stocks and delivery slot held for the order which are returned
+// to warehouse management system and delivery service
+type ReservationRelease struct {
+	// Key - idempotency key of release calls, unique per change of the order which frees reservations
+	Key     string
+	OrderID OrderID
+	UserID  UserID
+	// Items - stocks to release
+	Items []Item
+	// DeliveryVariantID, DeliverySlotID - delivery slot to release, zero when slot is kept
+	DeliveryVariantID DeliveryVariantID
+	DeliverySlotID    DeliverySlotID
+}
@@ -1,6 +1,14 @@
 package models
 
 type SKU struct {
-	ID   SKUID
-	Name string
+	ID    SKUID
+	Name  string
+	Price Money
+}
+
+// CatalogSKU - SKU card of the catalog
+type CatalogSKU struct {
+	SKU
+	// Available - SKU is listed and can be ordered
+	Available bool
 }
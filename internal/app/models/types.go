@@ -15,3 +15,5 @@ type SKUID uint64
 type WarehouseID uint64
 
 type DeliveryVariantID uint64
+
+type DeliverySlotID uint64
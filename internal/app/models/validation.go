@@ -0,0 +1,25 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldViolation - violation of a single request field
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError - request violates business rules
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v.Field, v.Description))
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
@@ -0,0 +1,82 @@
+package audit_storage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// CreateAuditRecord - saves record, sets its ID
+func (r *AuditStorage) CreateAuditRecord(ctx context.Context, record *models.AuditRecord) error {
+	const api = "audit_storage.CreateAuditRecord"
+
+	changes := record.Changes
+	if changes == nil {
+		changes = []models.AuditChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	query := squirrel.Insert(tableAuditLogName).
+		Columns(
+			"order_id",   // uuid
+			"actor",      // text
+			"action",     // text
+			"changes",    // jsonb
+			"client_ip",  // text
+			"request_id", // text
+			"created_at", // timestamptz
+		).
+		Values(
+			pgxuuid.UUID(record.OrderID),
+			record.Actor,
+			string(record.Action),
+			changesJSON,
+			record.ClientIP,
+			record.RequestID,
+			record.CreatedAt,
+		).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if err = r.driver.GetQueryEngine(ctx).Getx(ctx, &record.ID, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListAuditRecords - returns records of order with id greater than afterID in order they were made
+func (r *AuditStorage) ListAuditRecords(ctx context.Context, orderID models.OrderID, afterID int64, limit uint64) ([]models.AuditRecord, error) {
+	const api = "audit_storage.ListAuditRecords"
+
+	query := squirrel.Select(auditRecordColumns...).
+		From(tableAuditLogName).
+		Where(squirrel.Eq{"order_id": pgxuuid.UUID(orderID)}).
+		Where(squirrel.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []auditRecordRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]models.AuditRecord, 0, len(rows))
+	for i := range rows {
+		record, err := rows[i].ToModel()
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		res = append(res, record)
+	}
+
+	return res, nil
+}
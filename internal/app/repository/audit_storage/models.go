@@ -0,0 +1,39 @@
+package audit_storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+type auditRecordRow struct {
+	ID        int64        `db:"id"`
+	OrderID   pgxuuid.UUID `db:"order_id"`
+	Actor     string       `db:"actor"`
+	Action    string       `db:"action"`
+	Changes   []byte       `db:"changes"`
+	ClientIP  string       `db:"client_ip"`
+	RequestID string       `db:"request_id"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+func (r *auditRecordRow) ToModel() (models.AuditRecord, error) {
+	var changes []models.AuditChange
+	if err := json.Unmarshal(r.Changes, &changes); err != nil {
+		return models.AuditRecord{}, err
+	}
+
+	return models.AuditRecord{
+		ID:        r.ID,
+		OrderID:   models.OrderID(uuid.UUID(r.OrderID)),
+		Actor:     r.Actor,
+		Action:    models.AuditAction(r.Action),
+		Changes:   changes,
+		ClientIP:  r.ClientIP,
+		RequestID: r.RequestID,
+		CreatedAt: r.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package audit_storage
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/audit"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+var (
+	_ audit.Storage = (*AuditStorage)(nil)
+)
+
+type AuditStorage struct {
+	driver QueryEngineProvider
+}
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+func New(driver QueryEngineProvider) *AuditStorage {
+	return &AuditStorage{
+		driver: driver,
+	}
+}
+
+const (
+	tableAuditLogName = "audit_log"
+)
+
+var auditRecordColumns = []string{
+	"id",
+	"order_id",
+	"actor",
+	"action",
+	"changes",
+	"client_ip",
+	"request_id",
+	"created_at",
+}
@@ -0,0 +1,31 @@
+package inbox_storage
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+var (
+	_ inbox.Storage = (*InboxStorage)(nil)
+)
+
+type InboxStorage struct {
+	driver QueryEngineProvider
+}
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+func New(driver QueryEngineProvider) *InboxStorage {
+	return &InboxStorage{
+		driver: driver,
+	}
+}
+
+const (
+	tableInboxMessagesName    = "inbox_messages"
+	tableInboxDeadLettersName = "inbox_dead_letters"
+)
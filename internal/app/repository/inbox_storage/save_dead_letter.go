@@ -0,0 +1,52 @@
+package inbox_storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *InboxStorage) SaveDeadLetter(ctx context.Context, msg inbox.Message, attempts int, lastErr error) error {
+	const api = "inbox_storage.SaveDeadLetter"
+
+	headers, err := json.Marshal(msg.Headers)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	var payload []byte
+	if json.Valid(msg.Payload) {
+		payload = msg.Payload
+	}
+
+	query := squirrel.Insert(tableInboxDeadLettersName).
+		Columns(
+			"message_id", // text
+			"topic",      // text
+			"key",        // text
+			"headers",    // jsonb
+			"payload",    // jsonb
+			"attempts",   // int4
+			"last_error", // text
+		).
+		Values(
+			msg.ID,
+			msg.Topic,
+			sql.NullString{String: msg.Key, Valid: msg.Key != ""},
+			headers,
+			payload,
+			attempts,
+			lastErr.Error(),
+		).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err = r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package inbox_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/inbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// SaveMessage - remembers message as processed, returns false when it was processed before
+func (r *InboxStorage) SaveMessage(ctx context.Context, msg inbox.Message) (bool, error) {
+	const api = "inbox_storage.SaveMessage"
+
+	query := squirrel.Insert(tableInboxMessagesName).
+		Columns("message_id", "topic").
+		Values(msg.ID, msg.Topic).
+		Suffix("ON CONFLICT (message_id) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return false, pkgerrors.Wrap(api, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
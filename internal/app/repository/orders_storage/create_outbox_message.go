@@ -2,17 +2,62 @@ package orders_storage
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
 	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
 )
 
-func (r *OrdersStorage) CreateOutboxMessage(ctx context.Context, order *models.Order) error {
-	if _, err := r.driver.GetQueryEngine(ctx).Exec(ctx,
-		"INSERT INTO orders_outbox_messages (order_id) VALUES ($1)",
-		uuid.UUID(order.ID),
-	); err != nil {
-		return err
+// CreateOutboxMessage - saves event of order with payload of its change, events of one order are numbered
+// by sequence starting from 1, order row must be locked (or created) within the same transaction so that sequences do not race
+func (r *OrdersStorage) CreateOutboxMessage(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error {
+	const api = "orders_storage.CreateOutboxMessage"
+
+	// consumers get the change made by the event, e.g. changed fields of the order and their new values
+	payload, err := newEventPayload(event.Data)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	// consumers continue the trace of the request which caused the event
+	traceParent, traceState := tracing.TraceContext(ctx)
+
+	query := squirrel.Insert(tableOrdersOutboxMessagesName).
+		Columns("order_id", "event_type", "sequence", "payload", "traceparent", "tracestate").
+		Values(
+			uuid.UUID(order.ID),
+			string(event.Type),
+			squirrel.Expr(
+				"(SELECT COALESCE(MAX(sequence), 0) + 1 FROM "+tableOrdersOutboxMessagesName+" WHERE order_id = ?)",
+				uuid.UUID(order.ID),
+			),
+			payloadJSON,
+			sql.NullString{String: traceParent, Valid: traceParent != ""},
+			sql.NullString{String: traceState, Valid: traceState != ""},
+		).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err = r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
 	}
+
+	// notification is delivered to listeners on commit only, identical ones are sent once per transaction
+	notify := squirrel.Select().
+		Column(squirrel.Expr("pg_notify(?, '')", outbox.NotifyChannel)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err = r.driver.GetQueryEngine(ctx).Execx(ctx, notify); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
 	return nil
 }
@@ -0,0 +1,54 @@
+package orders_storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// GetOrder - returns order by id
+func (r *OrdersStorage) GetOrder(ctx context.Context, id models.OrderID) (*models.Order, error) {
+	const api = "orders_storage.GetOrder"
+
+	order, err := r.getOrder(ctx, id, "")
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return order, nil
+}
+
+// GetOrderForUpdate - returns order by id and locks it till the end of transaction
+func (r *OrdersStorage) GetOrderForUpdate(ctx context.Context, id models.OrderID) (*models.Order, error) {
+	const api = "orders_storage.GetOrderForUpdate"
+
+	order, err := r.getOrder(ctx, id, "FOR UPDATE")
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return order, nil
+}
+
+func (r *OrdersStorage) getOrder(ctx context.Context, id models.OrderID, suffix string) (*models.Order, error) {
+	query := squirrel.Select(orderColumns...).
+		From(tableOrdersName).
+		Where(squirrel.Eq{"id": uuid.UUID(id)}).
+		Suffix(suffix).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var row orderRow
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, &row, query); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return row.ToModel()
+}
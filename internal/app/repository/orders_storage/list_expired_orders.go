@@ -0,0 +1,36 @@
+package orders_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// ListExpiredOrders - returns ids of orders awaiting payment with payment deadline before now
+func (r *OrdersStorage) ListExpiredOrders(ctx context.Context, now time.Time, limit uint64) ([]models.OrderID, error) {
+	const api = "orders_storage.ListExpiredOrders"
+
+	query := squirrel.Select("id").
+		From(tableOrdersName).
+		Where(squirrel.Eq{"status": string(models.OrderStatusAwaitingPayment)}).
+		Where(squirrel.Lt{"payment_expires_at": now}).
+		OrderBy("payment_expires_at").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var ids []uuid.UUID
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &ids, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]models.OrderID, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, models.OrderID(id))
+	}
+
+	return res, nil
+}
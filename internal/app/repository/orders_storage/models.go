@@ -4,15 +4,22 @@ import (
 	"database/sql"
 	"encoding/json"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/pgmoney"
 	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
 	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
 )
 
+// orderItem - order line, name and unit price are snapshots at the moment of order creation,
+// amounts are in currency of the order
 type orderItem struct {
-	SKUID       int64 `json:"sku_id"`
-	Quantity    int32 `json:"quantity"`
-	WarehouseID int64 `json:"warehouse_id"`
+	SKUID       int64  `json:"sku_id"`
+	Quantity    int32  `json:"quantity"`
+	WarehouseID int64  `json:"warehouse_id"`
+	Name        string `json:"name"`
+	UnitPrice   string `json:"unit_price"` // decimal
+	Total       string `json:"total"`      // decimal
 }
 
 func getOrderItems(order *models.Order) []orderItem {
@@ -22,26 +29,75 @@ func getOrderItems(order *models.Order) []orderItem {
 			SKUID:       int64(order.Items[i].SKU.ID),
 			Quantity:    int32(order.Items[i].Quantity),
 			WarehouseID: int64(order.Items[i].WarehouseID),
+			Name:        order.Items[i].SKU.Name,
+			UnitPrice:   order.Items[i].UnitPrice.String(),
+			Total:       order.Items[i].Total.String(),
 		}
 	}
 	return items
 }
 
+func (i *orderItem) toModel(currencyCode string) (models.Item, error) {
+	unitPrice, err := models.ParseMoney(currencyCode, i.UnitPrice)
+	if err != nil {
+		return models.Item{}, err
+	}
+	total, err := models.ParseMoney(currencyCode, i.Total)
+	if err != nil {
+		return models.Item{}, err
+	}
+
+	return models.Item{
+		SKU: models.SKU{
+			ID:   models.SKUID(i.SKUID),
+			Name: i.Name,
+		},
+		Quantity:    uint32(i.Quantity),
+		WarehouseID: models.WarehouseID(i.WarehouseID),
+		UnitPrice:   unitPrice,
+		Total:       total,
+	}, nil
+}
+
 type orderRow struct {
-	ID                uuid.UUID     `db:"id"`
-	UserID            int64         `db:"user_id"`
-	Items             []byte        `db:"items"`
-	DeliveryVariantID sql.NullInt64 `db:"delivery_variant_id"`
-	DeliveryDate      sql.NullTime  `db:"delivery_date"`
+	ID                uuid.UUID      `db:"id"`
+	Version           int64          `db:"version"`
+	UserID            int64          `db:"user_id"`
+	Items             []byte         `db:"items"`
+	DeliveryVariantID sql.NullInt64  `db:"delivery_variant_id"`
+	DeliveryDate      sql.NullTime   `db:"delivery_date"`
+	DeliverySlotID    sql.NullInt64  `db:"delivery_slot_id"`
+	CurrencyCode      sql.NullString `db:"currency_code"`
+	Subtotal          pgtype.Numeric `db:"subtotal"`
+	DeliveryCost      pgtype.Numeric `db:"delivery_cost"`
+	Discount          pgtype.Numeric `db:"discount"`
+	PromoCode         sql.NullString `db:"promo_code"`
+	Total             pgtype.Numeric `db:"total"`
+	Status            string         `db:"status"`
+	PaymentID         sql.NullString `db:"payment_id"`
+	PaymentURL        sql.NullString `db:"payment_url"`
+	PaymentExpiresAt  sql.NullTime   `db:"payment_expires_at"`
 }
 
 func (r *orderRow) ValuesMap() map[string]any {
 	return map[string]any{
 		"id":                  r.ID,
+		"version":             r.Version,
 		"user_id":             r.UserID,
 		"items":               r.Items,
 		"delivery_variant_id": r.DeliveryVariantID,
 		"delivery_date":       r.DeliveryDate,
+		"delivery_slot_id":    r.DeliverySlotID,
+		"currency_code":       r.CurrencyCode,
+		"subtotal":            r.Subtotal,
+		"delivery_cost":       r.DeliveryCost,
+		"discount":            r.Discount,
+		"promo_code":          r.PromoCode,
+		"total":               r.Total,
+		"status":              r.Status,
+		"payment_id":          r.PaymentID,
+		"payment_url":         r.PaymentURL,
+		"payment_expires_at":  r.PaymentExpiresAt,
 	}
 }
 
@@ -63,16 +119,106 @@ func newOrderRowFromModelsOrder(order *models.Order) (*orderRow, error) {
 	}
 
 	return &orderRow{
-		ID:     uuid.UUID(order.ID),
-		UserID: int64(order.UserID),
-		Items:  items,
+		ID:      uuid.UUID(order.ID),
+		Version: order.Version,
+		UserID:  int64(order.UserID),
+		Items:   items,
 		DeliveryVariantID: sql.NullInt64{
 			Int64: int64(order.DeliveryVariantID),
 			Valid: order.DeliveryVariantID != 0,
 		},
 		DeliveryDate: sql.NullTime{
 			Time:  order.DeliveryDate,
-			Valid: order.DeliveryDate.IsZero(),
+			Valid: !order.DeliveryDate.IsZero(),
+		},
+		DeliverySlotID: sql.NullInt64{
+			Int64: int64(order.DeliverySlotID),
+			Valid: order.DeliverySlotID != 0,
+		},
+		CurrencyCode: sql.NullString{
+			String: order.CurrencyCode,
+			Valid:  order.CurrencyCode != "",
+		},
+		Subtotal:     pgmoney.ToNumeric(order.Subtotal),
+		DeliveryCost: pgmoney.ToNumeric(order.DeliveryCost),
+		Discount:     pgmoney.ToNumeric(order.Discount),
+		PromoCode: sql.NullString{
+			String: order.PromoCode,
+			Valid:  order.PromoCode != "",
+		},
+		Total:  pgmoney.ToNumeric(order.Total),
+		Status: string(order.Status),
+		PaymentID: sql.NullString{
+			String: string(order.PaymentID),
+			Valid:  order.PaymentID != "",
+		},
+		PaymentURL: sql.NullString{
+			String: order.PaymentURL,
+			Valid:  order.PaymentURL != "",
+		},
+		PaymentExpiresAt: sql.NullTime{
+			Time:  order.PaymentExpiresAt,
+			Valid: !order.PaymentExpiresAt.IsZero(),
 		},
 	}, nil
 }
+
+func (r *orderRow) ToModel() (*models.Order, error) {
+	const api = "orderRow.ToModel"
+
+	var rowItems []orderItem
+	if len(r.Items) > 0 {
+		if err := json.Unmarshal(r.Items, &rowItems); err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+	}
+
+	items := make([]models.Item, 0, len(rowItems))
+	for i := range rowItems {
+		item, err := rowItems[i].toModel(r.CurrencyCode.String)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		items = append(items, item)
+	}
+
+	order := &models.Order{
+		ID:      models.OrderID(r.ID),
+		Version: r.Version,
+		UserID:  models.UserID(r.UserID),
+		Status:  models.OrderStatus(r.Status),
+		Items:   items,
+		DeliveryOrderInfo: models.DeliveryOrderInfo{
+			DeliveryVariantID: models.DeliveryVariantID(r.DeliveryVariantID.Int64),
+			DeliveryDate:      r.DeliveryDate.Time,
+			DeliverySlotID:    models.DeliverySlotID(r.DeliverySlotID.Int64),
+		},
+		OrderPricing: models.OrderPricing{
+			CurrencyCode: r.CurrencyCode.String,
+			PromoCode:    r.PromoCode.String,
+		},
+		PaymentOrderInfo: models.PaymentOrderInfo{
+			PaymentID:        models.PaymentID(r.PaymentID.String),
+			PaymentURL:       r.PaymentURL.String,
+			PaymentExpiresAt: r.PaymentExpiresAt.Time,
+		},
+	}
+
+	for _, m := range []struct {
+		dst *models.Money
+		src pgtype.Numeric
+	}{
+		{&order.Subtotal, r.Subtotal},
+		{&order.DeliveryCost, r.DeliveryCost},
+		{&order.Discount, r.Discount},
+		{&order.Total, r.Total},
+	} {
+		amount, err := pgmoney.FromNumeric(m.src, r.CurrencyCode.String)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		*m.dst = amount
+	}
+
+	return order, nil
+}
@@ -0,0 +1,192 @@
+package orders_storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// AppendOrderEvent - appends event to order stream and writes order, the state after event, to orders projection.
+// Created event starts the stream, models.ErrAlreadyExists is returned when order with the id exists.
+// Other events are compare-and-swap: models.ErrVersionConflict is returned when order version
+// is not the one preceding the event, i.e. order was changed concurrently
+func (r *OrdersStorage) AppendOrderEvent(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error {
+	const api = "orders_storage.AppendOrderEvent"
+
+	payload, err := newEventPayload(event.Data)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	query := squirrel.Insert(tableOrderEventsName).
+		Columns(
+			"order_id",   // uuid
+			"version",    // int8
+			"event_type", // text
+			"payload",    // jsonb
+			"created_at", // timestamptz
+		).
+		Values(
+			uuid.UUID(event.OrderID),
+			event.Version,
+			string(event.Type),
+			payloadJSON,
+			event.OccurredAt,
+		).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err = r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			if event.Type == models.OrderEventCreated {
+				return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+			}
+			return pkgerrors.Wrap(api, models.ErrVersionConflict)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	// projection is swapped only from the version preceding the event
+	saved, err := r.saveOrderProjection(ctx, order, "orders.version = EXCLUDED.version - 1")
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if !saved {
+		return pkgerrors.Wrap(api, models.ErrVersionConflict)
+	}
+
+	return nil
+}
+
+// ListOrderEvents - returns stream of the order in order of versions
+func (r *OrdersStorage) ListOrderEvents(ctx context.Context, orderID models.OrderID) ([]models.OrderDomainEvent, error) {
+	const api = "orders_storage.ListOrderEvents"
+
+	query := squirrel.Select("order_id", "version", "event_type", "payload", "created_at").
+		From(tableOrderEventsName).
+		Where(squirrel.Eq{"order_id": uuid.UUID(orderID)}).
+		OrderBy("version").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []orderEventRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]models.OrderDomainEvent, 0, len(rows))
+	for i := range rows {
+		event, err := rows[i].ToModel()
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		res = append(res, event)
+	}
+
+	return res, nil
+}
+
+// ListEventStreams - returns ids of orders having events greater than afterID in ascending order
+func (r *OrdersStorage) ListEventStreams(ctx context.Context, afterID models.OrderID, limit uint64) ([]models.OrderID, error) {
+	const api = "orders_storage.ListEventStreams"
+
+	query := squirrel.Select("order_id").
+		From(tableOrderEventsName).
+		Where(squirrel.Eq{"version": 1}).
+		Where(squirrel.Gt{"order_id": uuid.UUID(afterID)}).
+		OrderBy("order_id").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var ids []uuid.UUID
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &ids, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]models.OrderID, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, models.OrderID(id))
+	}
+
+	return res, nil
+}
+
+// SaveOrderProjection - inserts or overwrites row of the order in orders projection regardless of its version
+func (r *OrdersStorage) SaveOrderProjection(ctx context.Context, order *models.Order) error {
+	const api = "orders_storage.SaveOrderProjection"
+
+	if _, err := r.saveOrderProjection(ctx, order, ""); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// saveOrderProjection - inserts or overwrites row of the order when existing row matches condition,
+// returns false when row is not overwritten
+func (r *OrdersStorage) saveOrderProjection(ctx context.Context, order *models.Order, condition string) (bool, error) {
+	row, err := newOrderRowFromModelsOrder(order)
+	if err != nil {
+		return false, err
+	}
+
+	columns := []string{
+		"id",                  // uuid
+		"version",             // int8
+		"user_id",             // int8
+		"items",               // json
+		"delivery_variant_id", // int8
+		"delivery_date",       // timestamp
+		"delivery_slot_id",    // int8
+		"currency_code",       // varchar(3)
+		"subtotal",            // numeric
+		"delivery_cost",       // numeric
+		"discount",            // numeric
+		"promo_code",          // text
+		"total",               // numeric
+		"status",              // text
+		"payment_id",          // text
+		"payment_url",         // text
+		"payment_expires_at",  // timestamptz
+	}
+
+	suffix := onConflictUpdate("id", columns[1:]...) + ", updated_at = now()"
+	if condition != "" {
+		suffix += " WHERE " + condition
+	}
+
+	query := squirrel.Insert(tableOrdersName).
+		Columns(columns...).
+		Values(row.Values(columns...)...).
+		Suffix(suffix).
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// onConflictUpdate - upsert clause overwriting columns with inserted values
+func onConflictUpdate(key string, columns ...string) string {
+	clause := "ON CONFLICT (" + key + ") DO UPDATE SET "
+	for i, column := range columns {
+		if i > 0 {
+			clause += ", "
+		}
+		clause += column + " = EXCLUDED." + column
+	}
+	return clause
+}
@@ -0,0 +1,230 @@
+package orders_storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// eventPayload - payload of order_events row, fields are set depending on event type
+type eventPayload struct {
+	// order.created
+	UserID int64  `json:"user_id,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	// order.created, order.updated
+	DeliveryVariantID int64      `json:"delivery_variant_id,omitempty"`
+	DeliveryDate      *time.Time `json:"delivery_date,omitempty"`
+	DeliverySlotID    int64      `json:"delivery_slot_id,omitempty"`
+	PaymentURL        string     `json:"payment_url,omitempty"`
+	PaymentExpiresAt  *time.Time `json:"payment_expires_at,omitempty"`
+
+	// order.created, order.updated
+	Items        []orderItem `json:"items,omitempty"`
+	CurrencyCode string      `json:"currency_code,omitempty"`
+	Subtotal     string      `json:"subtotal,omitempty"`      // decimal
+	DeliveryCost string      `json:"delivery_cost,omitempty"` // decimal
+	Discount     string      `json:"discount,omitempty"`      // decimal
+	PromoCode    string      `json:"promo_code,omitempty"`
+	Total        string      `json:"total,omitempty"` // decimal
+
+	// order.created, order.updated, order.paid
+	PaymentID string `json:"payment_id,omitempty"`
+
+	// order.updated
+	Fields []string `json:"fields,omitempty"`
+
+	// order.cancelled
+	Reason string `json:"reason,omitempty"`
+}
+
+type orderEventRow struct {
+	OrderID   pgxuuid.UUID `db:"order_id"`
+	Version   int64        `db:"version"`
+	EventType string       `db:"event_type"`
+	Payload   []byte       `db:"payload"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+func newEventPayload(data models.OrderEventData) (*eventPayload, error) {
+	switch d := data.(type) {
+	case models.OrderCreatedData:
+		order := &d.Order
+		p := &eventPayload{
+			UserID:            int64(order.UserID),
+			Status:            string(order.Status),
+			DeliveryVariantID: int64(order.DeliveryVariantID),
+			DeliveryDate:      timePtr(order.DeliveryDate),
+			DeliverySlotID:    int64(order.DeliverySlotID),
+			PaymentURL:        order.PaymentURL,
+			PaymentExpiresAt:  timePtr(order.PaymentExpiresAt),
+			PaymentID:         string(order.PaymentID),
+		}
+		p.setPricing(order.Items, order.OrderPricing)
+		return p, nil
+	case models.OrderUpdatedData:
+		p := &eventPayload{
+			Fields:            d.Fields,
+			DeliveryVariantID: int64(d.Delivery.DeliveryVariantID),
+			DeliveryDate:      timePtr(d.Delivery.DeliveryDate),
+			DeliverySlotID:    int64(d.Delivery.DeliverySlotID),
+			PaymentID:         string(d.Payment.PaymentID),
+			PaymentURL:        d.Payment.PaymentURL,
+			PaymentExpiresAt:  timePtr(d.Payment.PaymentExpiresAt),
+		}
+		p.setPricing(d.Items, d.Pricing)
+		return p, nil
+	case models.OrderPaidData:
+		return &eventPayload{PaymentID: string(d.PaymentID)}, nil
+	case models.OrderCancelledData:
+		return &eventPayload{Reason: d.Reason}, nil
+	default:
+		return nil, fmt.Errorf("unknown order event data %T", data)
+	}
+}
+
+func (p *eventPayload) setPricing(items []models.Item, pricing models.OrderPricing) {
+	p.Items = getOrderItems(&models.Order{Items: items})
+	p.CurrencyCode = pricing.CurrencyCode
+	p.Subtotal = formatMoney(pricing.Subtotal)
+	p.DeliveryCost = formatMoney(pricing.DeliveryCost)
+	p.Discount = formatMoney(pricing.Discount)
+	p.PromoCode = pricing.PromoCode
+	p.Total = formatMoney(pricing.Total)
+}
+
+func (p *eventPayload) pricing() ([]models.Item, models.OrderPricing, error) {
+	items := make([]models.Item, 0, len(p.Items))
+	for i := range p.Items {
+		item, err := p.Items[i].toModel(p.CurrencyCode)
+		if err != nil {
+			return nil, models.OrderPricing{}, err
+		}
+		items = append(items, item)
+	}
+
+	pricing := models.OrderPricing{
+		CurrencyCode: p.CurrencyCode,
+		PromoCode:    p.PromoCode,
+	}
+	for _, m := range []struct {
+		dst *models.Money
+		src string
+	}{
+		{&pricing.Subtotal, p.Subtotal},
+		{&pricing.DeliveryCost, p.DeliveryCost},
+		{&pricing.Discount, p.Discount},
+		{&pricing.Total, p.Total},
+	} {
+		amount, err := parseMoney(p.CurrencyCode, m.src)
+		if err != nil {
+			return nil, models.OrderPricing{}, err
+		}
+		*m.dst = amount
+	}
+
+	return items, pricing, nil
+}
+
+func (p *eventPayload) delivery() models.DeliveryOrderInfo {
+	return models.DeliveryOrderInfo{
+		DeliveryVariantID: models.DeliveryVariantID(p.DeliveryVariantID),
+		DeliveryDate:      timeValue(p.DeliveryDate),
+		DeliverySlotID:    models.DeliverySlotID(p.DeliverySlotID),
+	}
+}
+
+func (p *eventPayload) payment() models.PaymentOrderInfo {
+	return models.PaymentOrderInfo{
+		PaymentID:        models.PaymentID(p.PaymentID),
+		PaymentURL:       p.PaymentURL,
+		PaymentExpiresAt: timeValue(p.PaymentExpiresAt),
+	}
+}
+
+func (r *orderEventRow) ToModel() (models.OrderDomainEvent, error) {
+	const api = "orderEventRow.ToModel"
+
+	var p eventPayload
+	if err := json.Unmarshal(r.Payload, &p); err != nil {
+		return models.OrderDomainEvent{}, pkgerrors.Wrap(api, err)
+	}
+
+	event := models.OrderDomainEvent{
+		OrderID:    models.OrderID(uuid.UUID(r.OrderID)),
+		Version:    r.Version,
+		Type:       models.OrderEventType(r.EventType),
+		OccurredAt: r.CreatedAt,
+	}
+
+	switch event.Type {
+	case models.OrderEventCreated:
+		items, pricing, err := p.pricing()
+		if err != nil {
+			return models.OrderDomainEvent{}, pkgerrors.Wrap(api, err)
+		}
+		event.Data = models.OrderCreatedData{Order: models.Order{
+			ID:                event.OrderID,
+			UserID:            models.UserID(p.UserID),
+			Status:            models.OrderStatus(p.Status),
+			Items:             items,
+			DeliveryOrderInfo: p.delivery(),
+			OrderPricing:      pricing,
+			PaymentOrderInfo:  p.payment(),
+		}}
+	case models.OrderEventUpdated:
+		items, pricing, err := p.pricing()
+		if err != nil {
+			return models.OrderDomainEvent{}, pkgerrors.Wrap(api, err)
+		}
+		event.Data = models.OrderUpdatedData{
+			Fields:   p.Fields,
+			Items:    items,
+			Delivery: p.delivery(),
+			Pricing:  pricing,
+			Payment:  p.payment(),
+		}
+	case models.OrderEventPaid:
+		event.Data = models.OrderPaidData{PaymentID: models.PaymentID(p.PaymentID)}
+	case models.OrderEventCancelled:
+		event.Data = models.OrderCancelledData{Reason: p.Reason}
+	default:
+		return models.OrderDomainEvent{}, pkgerrors.Wrap(api,
+			fmt.Errorf("unknown event type %q: %w", r.EventType, models.ErrInvalidOrderEvents))
+	}
+
+	return event, nil
+}
+
+func formatMoney(m models.Money) string {
+	if m.IsZero() {
+		return ""
+	}
+	return m.String()
+}
+
+func parseMoney(currencyCode, amount string) (models.Money, error) {
+	if amount == "" {
+		return models.Money{CurrencyCode: currencyCode}, nil
+	}
+	return models.ParseMoney(currencyCode, amount)
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
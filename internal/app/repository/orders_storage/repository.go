@@ -5,12 +5,14 @@ import (
 
 	"github.com/jackc/pgx/v5/pgconn"
 	oms "github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/projections"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/postgres"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
 )
 
 var (
-	_ oms.OrdersStorage = (*OrdersStorage)(nil)
+	_ oms.OrdersStorage   = (*OrdersStorage)(nil)
+	_ projections.Storage = (*OrdersStorage)(nil)
 )
 
 type Connection interface {
@@ -32,5 +34,29 @@ func New(driver QueryEngineProvider) *OrdersStorage {
 }
 
 const (
-	tableOrdersName = "orders"
+	tableOrdersName               = "orders"
+	tableOrdersOutboxMessagesName = "orders_outbox_messages"
+	tableOrderStatusHistoryName   = "order_status_history"
+	tableOrderEventsName          = "order_events"
 )
+
+// orderColumns - columns of orders table read into orderRow
+var orderColumns = []string{
+	"id",
+	"version",
+	"user_id",
+	"items",
+	"delivery_variant_id",
+	"delivery_date",
+	"delivery_slot_id",
+	"currency_code",
+	"subtotal",
+	"delivery_cost",
+	"discount",
+	"promo_code",
+	"total",
+	"status",
+	"payment_id",
+	"payment_url",
+	"payment_expires_at",
+}
@@ -0,0 +1,67 @@
+package orders_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+type statusHistoryRow struct {
+	ID        int64        `db:"id"`
+	OrderID   pgxuuid.UUID `db:"order_id"`
+	Status    string       `db:"status"`
+	EventType string       `db:"event_type"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+// CreateStatusHistory - saves order status transition, sets ID of event
+func (r *OrdersStorage) CreateStatusHistory(ctx context.Context, event *models.OrderEvent) error {
+	const api = "orders_storage.CreateStatusHistory"
+
+	query := squirrel.Insert(tableOrderStatusHistoryName).
+		Columns("order_id", "status", "event_type", "created_at").
+		Values(pgxuuid.UUID(event.OrderID), string(event.Status), string(event.Type), event.OccurredAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, &event.ID, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListStatusHistory - returns transitions of order with id greater than afterID in order they happened
+func (r *OrdersStorage) ListStatusHistory(ctx context.Context, orderID models.OrderID, afterID int64) ([]models.OrderEvent, error) {
+	const api = "orders_storage.ListStatusHistory"
+
+	query := squirrel.Select("id", "order_id", "status", "event_type", "created_at").
+		From(tableOrderStatusHistoryName).
+		Where(squirrel.Eq{"order_id": pgxuuid.UUID(orderID)}).
+		Where(squirrel.Gt{"id": afterID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []statusHistoryRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]models.OrderEvent, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, models.OrderEvent{
+			ID:         row.ID,
+			OrderID:    models.OrderID(uuid.UUID(row.OrderID)),
+			Type:       models.OrderEventType(row.EventType),
+			Status:     models.OrderStatus(row.Status),
+			OccurredAt: row.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package outbox_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *OutboxStorage) FetchPending(ctx context.Context, partition outbox.Partition, now time.Time, limit uint64) ([]outbox.Message, error) {
+	const api = "outbox_storage.FetchPending"
+
+	query := squirrel.Select(messageColumns...).
+		From(tableOrdersOutboxMessagesName+" m").
+		Where(squirrel.Eq{"m.status": string(outbox.StatusPending)}).
+		Where(squirrel.LtOrEq{"m.next_attempt_at": now}).
+		// aggregate belongs to partition by hash of its id
+		Where("mod(abs(hashtext(m.order_id::text)::int8), ?) = ?", partition.Count, partition.Index).
+		// only the first unsent message of aggregate is due
+		Where("NOT EXISTS (SELECT 1 FROM "+tableOrdersOutboxMessagesName+" p"+
+			" WHERE p.order_id = m.order_id AND p.sequence < m.sequence AND p.status <> ?)", string(outbox.StatusSent)).
+		OrderBy("m.id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []messageRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return messagesFromRows(rows), nil
+}
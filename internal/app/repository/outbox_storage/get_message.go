@@ -0,0 +1,52 @@
+package outbox_storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *OutboxStorage) GetMessage(ctx context.Context, id int64) (*outbox.Message, error) {
+	const api = "outbox_storage.GetMessage"
+
+	msg, err := r.getMessage(ctx, id, "")
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	return msg, nil
+}
+
+// GetMessageForUpdate - returns message by id and locks it till the end of transaction
+func (r *OutboxStorage) GetMessageForUpdate(ctx context.Context, id int64) (*outbox.Message, error) {
+	const api = "outbox_storage.GetMessageForUpdate"
+
+	msg, err := r.getMessage(ctx, id, "FOR UPDATE")
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	return msg, nil
+}
+
+func (r *OutboxStorage) getMessage(ctx context.Context, id int64, suffix string) (*outbox.Message, error) {
+	query := squirrel.Select(messageColumns...).
+		From(tableOrdersOutboxMessagesName).
+		Where(squirrel.Eq{"id": id}).
+		Suffix(suffix).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var row messageRow
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, &row, query); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	msg := row.ToModel()
+	return &msg, nil
+}
@@ -0,0 +1,28 @@
+package outbox_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *OutboxStorage) ListDead(ctx context.Context, afterID int64, limit uint64) ([]outbox.Message, error) {
+	const api = "outbox_storage.ListDead"
+
+	query := squirrel.Select(messageColumns...).
+		From(tableOrdersOutboxMessagesName).
+		Where(squirrel.Eq{"status": string(outbox.StatusDead)}).
+		Where(squirrel.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []messageRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return messagesFromRows(rows), nil
+}
@@ -0,0 +1,52 @@
+package outbox_storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+type messageRow struct {
+	ID            int64          `db:"id"`
+	OrderID       pgxuuid.UUID   `db:"order_id"`
+	Sequence      int64          `db:"sequence"`
+	EventType     string         `db:"event_type"`
+	Payload       []byte         `db:"payload"`
+	CreatedAt     time.Time      `db:"created_at"`
+	Status        string         `db:"status"`
+	Attempts      int32          `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	SentAt        sql.NullTime   `db:"sent_at"`
+	TraceParent   sql.NullString `db:"traceparent"`
+	TraceState    sql.NullString `db:"tracestate"`
+}
+
+func (r *messageRow) ToModel() outbox.Message {
+	return outbox.Message{
+		ID:            r.ID,
+		AggregateID:   uuid.UUID(r.OrderID).String(),
+		Sequence:      r.Sequence,
+		EventType:     r.EventType,
+		Payload:       r.Payload,
+		CreatedAt:     r.CreatedAt,
+		Status:        outbox.Status(r.Status),
+		Attempts:      int(r.Attempts),
+		LastError:     r.LastError.String,
+		NextAttemptAt: r.NextAttemptAt,
+		SentAt:        r.SentAt.Time,
+		TraceParent:   r.TraceParent.String,
+		TraceState:    r.TraceState.String,
+	}
+}
+
+func messagesFromRows(rows []messageRow) []outbox.Message {
+	res := make([]outbox.Message, 0, len(rows))
+	for i := range rows {
+		res = append(res, rows[i].ToModel())
+	}
+	return res
+}
@@ -0,0 +1,47 @@
+package outbox_storage
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+var (
+	_ outbox.Storage = (*OutboxStorage)(nil)
+)
+
+type OutboxStorage struct {
+	driver QueryEngineProvider
+}
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+func New(driver QueryEngineProvider) *OutboxStorage {
+	return &OutboxStorage{
+		driver: driver,
+	}
+}
+
+const (
+	tableOrdersOutboxMessagesName = "orders_outbox_messages"
+)
+
+// messageColumns - columns of outbox table read into messageRow
+var messageColumns = []string{
+	"id",
+	"order_id",
+	"sequence",
+	"event_type",
+	"payload",
+	"created_at",
+	"status",
+	"attempts",
+	"last_error",
+	"next_attempt_at",
+	"sent_at",
+	"traceparent",
+	"tracestate",
+}
@@ -0,0 +1,36 @@
+package outbox_storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *OutboxStorage) UpdateDelivery(ctx context.Context, msg *outbox.Message) error {
+	const api = "outbox_storage.UpdateDelivery"
+
+	query := squirrel.Update(tableOrdersOutboxMessagesName).
+		SetMap(map[string]any{
+			"status":          string(msg.Status),
+			"attempts":        msg.Attempts,
+			"last_error":      sql.NullString{String: msg.LastError, Valid: msg.LastError != ""},
+			"next_attempt_at": msg.NextAttemptAt,
+			"sent_at":         sql.NullTime{Time: msg.SentAt, Valid: !msg.SentAt.IsZero()},
+		}).
+		Where(squirrel.Eq{"id": msg.ID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pkgerrors.Wrap(api, models.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Package pgmoney - exact conversions between models.Money and postgres numeric
+package pgmoney
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+const scale = 9 // nanos
+
+var nanosPerUnit = big.NewInt(1_000_000_000)
+
+// ToNumeric - converts amount to numeric with scale 9
+func ToNumeric(m models.Money) pgtype.Numeric {
+	value := new(big.Int).Mul(big.NewInt(m.Units), nanosPerUnit)
+	value.Add(value, big.NewInt(int64(m.Nanos)))
+
+	return pgtype.Numeric{
+		Int:   value,
+		Exp:   -scale,
+		Valid: true,
+	}
+}
+
+// FromNumeric - converts numeric to amount in currency, numeric must have at most 9 fractional digits
+func FromNumeric(n pgtype.Numeric, currencyCode string) (models.Money, error) {
+	if !n.Valid {
+		return models.Money{CurrencyCode: currencyCode}, nil
+	}
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return models.Money{}, fmt.Errorf("pgmoney: numeric is not finite")
+	}
+
+	value := new(big.Int).Set(n.Int)
+	switch exp := n.Exp + scale; {
+	case exp > 0:
+		value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	case exp < 0:
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil)
+		if new(big.Int).Rem(value, divisor).Sign() != 0 {
+			return models.Money{}, fmt.Errorf("pgmoney: numeric has more than %d fractional digits", scale)
+		}
+		value.Quo(value, divisor)
+	}
+
+	units, nanos := new(big.Int).QuoRem(value, nanosPerUnit, new(big.Int))
+	if !units.IsInt64() {
+		return models.Money{}, fmt.Errorf("pgmoney: numeric is out of range")
+	}
+
+	return models.Money{
+		CurrencyCode: currencyCode,
+		Units:        units.Int64(),
+		Nanos:        int32(nanos.Int64()),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package promotions_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// CountRedemptions - returns how many times user redeemed campaign
+func (r *PromotionsStorage) CountRedemptions(ctx context.Context, campaignID models.PromoCampaignID, userID models.UserID) (uint32, error) {
+	const api = "promotions_storage.CountRedemptions"
+
+	query := squirrel.Select("count(*)").
+		From(tablePromoRedemptionsName).
+		Where(squirrel.Eq{
+			"campaign_id": int64(campaignID),
+			"user_id":     int64(userID),
+		}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var count int64
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, &count, query); err != nil {
+		return 0, pkgerrors.Wrap(api, err)
+	}
+
+	return uint32(count), nil
+}
@@ -0,0 +1,34 @@
+package promotions_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+func (r *PromotionsStorage) CreateRedemption(ctx context.Context, redemption *models.PromoRedemption) error {
+	const api = "promotions_storage.CreateRedemption"
+
+	row := newPromoRedemptionRowFromModel(redemption)
+
+	columns := []string{
+		"campaign_id",   // int8
+		"user_id",       // int8
+		"order_id",      // uuid
+		"discount",      // numeric
+		"currency_code", // varchar(3)
+	}
+
+	query := squirrel.Insert(tablePromoRedemptionsName).
+		Columns(columns...).
+		Values(row.Values(columns...)...).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package promotions_storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// GetCampaignByCode - returns campaign by promo code
+func (r *PromotionsStorage) GetCampaignByCode(ctx context.Context, code string) (*models.PromoCampaign, error) {
+	const api = "promotions_storage.GetCampaignByCode"
+
+	query := squirrel.Select(
+		"id",
+		"code",
+		"discount_type",
+		"percent_off",
+		"amount_off",
+		"currency_code",
+		"valid_from",
+		"valid_to",
+		"usage_limit_per_user",
+	).
+		From(tablePromoCampaignsName).
+		Where(squirrel.Eq{"code": code}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var row promoCampaignRow
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, &row, query); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, pkgerrors.Wrap(api, models.ErrNotFound)
+		}
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	campaign, err := row.ToModel()
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return campaign, nil
+}
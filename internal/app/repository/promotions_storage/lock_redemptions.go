@@ -0,0 +1,26 @@
+package promotions_storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// LockRedemptions - locks redemptions of campaign by user till the end of transaction,
+// so that concurrent orders of the user do not exceed usage limit, orders of other users do not wait
+func (r *PromotionsStorage) LockRedemptions(ctx context.Context, campaignID models.PromoCampaignID, userID models.UserID) error {
+	const api = "promotions_storage.LockRedemptions"
+
+	query := squirrel.Select().
+		Column(squirrel.Expr("pg_advisory_xact_lock(hashtext(?))", fmt.Sprintf("%s/%d/%d", tablePromoRedemptionsName, campaignID, userID))).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
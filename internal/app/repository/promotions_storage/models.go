@@ -0,0 +1,80 @@
+package promotions_storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/pgmoney"
+	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+type promoCampaignRow struct {
+	ID                int64          `db:"id"`
+	Code              string         `db:"code"`
+	DiscountType      string         `db:"discount_type"`
+	PercentOff        sql.NullInt32  `db:"percent_off"`
+	AmountOff         pgtype.Numeric `db:"amount_off"`
+	CurrencyCode      sql.NullString `db:"currency_code"`
+	ValidFrom         time.Time      `db:"valid_from"`
+	ValidTo           time.Time      `db:"valid_to"`
+	UsageLimitPerUser int32          `db:"usage_limit_per_user"`
+}
+
+func (r *promoCampaignRow) ToModel() (*models.PromoCampaign, error) {
+	amountOff, err := pgmoney.FromNumeric(r.AmountOff, r.CurrencyCode.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PromoCampaign{
+		ID:                models.PromoCampaignID(r.ID),
+		Code:              r.Code,
+		DiscountType:      models.DiscountType(r.DiscountType),
+		PercentOff:        uint32(r.PercentOff.Int32),
+		AmountOff:         amountOff,
+		ValidFrom:         r.ValidFrom,
+		ValidTo:           r.ValidTo,
+		UsageLimitPerUser: uint32(r.UsageLimitPerUser),
+	}, nil
+}
+
+type promoRedemptionRow struct {
+	CampaignID   int64          `db:"campaign_id"`
+	UserID       int64          `db:"user_id"`
+	OrderID      uuid.UUID      `db:"order_id"`
+	Discount     pgtype.Numeric `db:"discount"`
+	CurrencyCode string         `db:"currency_code"`
+}
+
+func newPromoRedemptionRowFromModel(redemption *models.PromoRedemption) *promoRedemptionRow {
+	return &promoRedemptionRow{
+		CampaignID:   int64(redemption.CampaignID),
+		UserID:       int64(redemption.UserID),
+		OrderID:      uuid.UUID(redemption.OrderID),
+		Discount:     pgmoney.ToNumeric(redemption.Discount),
+		CurrencyCode: redemption.Discount.CurrencyCode,
+	}
+}
+
+func (r *promoRedemptionRow) ValuesMap() map[string]any {
+	return map[string]any{
+		"campaign_id":   r.CampaignID,
+		"user_id":       r.UserID,
+		"order_id":      r.OrderID,
+		"discount":      r.Discount,
+		"currency_code": r.CurrencyCode,
+	}
+}
+
+func (r *promoRedemptionRow) Values(columns ...string) []any {
+	values := make([]any, 0, len(columns))
+	m := r.ValuesMap()
+
+	for i := range columns {
+		values = append(values, m[columns[i]])
+	}
+
+	return values
+}
@@ -0,0 +1,31 @@
+package promotions_storage
+
+import (
+	"context"
+
+	oms "github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+var (
+	_ oms.PromotionsStorage = (*PromotionsStorage)(nil)
+)
+
+type PromotionsStorage struct {
+	driver QueryEngineProvider
+}
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+func New(driver QueryEngineProvider) *PromotionsStorage {
+	return &PromotionsStorage{
+		driver: driver,
+	}
+}
+
+const (
+	tablePromoCampaignsName   = "promo_campaigns"
+	tablePromoRedemptionsName = "promo_redemptions"
+)
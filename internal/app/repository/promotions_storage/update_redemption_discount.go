@@ -0,0 +1,33 @@
+package promotions_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/repository/pgmoney"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	uuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+// UpdateRedemptionDiscount - sets discount of redemption of the order after the order is repriced,
+// models.ErrNotFound is returned when order has no redemption
+func (r *PromotionsStorage) UpdateRedemptionDiscount(ctx context.Context, orderID models.OrderID, discount models.Money) error {
+	const api = "promotions_storage.UpdateRedemptionDiscount"
+
+	query := squirrel.Update(tablePromoRedemptionsName).
+		Set("discount", pgmoney.ToNumeric(discount)).
+		Set("currency_code", discount.CurrencyCode).
+		Where(squirrel.Eq{"order_id": uuid.UUID(orderID)}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pkgerrors.Wrap(api, models.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package webhooks_storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+func (r *WebhooksStorage) CreateDeliveries(ctx context.Context, deliveries []webhooks.Delivery) error {
+	const api = "webhooks_storage.CreateDeliveries"
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := squirrel.Insert(tableWebhookDeliveriesName).
+		Columns(
+			"subscription_id", // uuid
+			"event_id",        // text
+			"event_type",      // text
+			"payload",         // bytea
+			"status",          // text
+			"next_attempt_at", // timestamptz
+		).
+		Suffix("ON CONFLICT (subscription_id, event_id) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, d := range deliveries {
+		query = query.Values(
+			pgxuuid.UUID(d.SubscriptionID),
+			d.EventID,
+			d.EventType,
+			d.Payload,
+			string(d.Status),
+			d.NextAttemptAt,
+		)
+	}
+
+	if _, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (r *WebhooksStorage) FetchDueDeliveries(ctx context.Context, now time.Time, limit uint64) ([]webhooks.Delivery, error) {
+	const api = "webhooks_storage.FetchDueDeliveries"
+
+	query := squirrel.Select(
+		"d.id",
+		"d.subscription_id",
+		"d.event_id",
+		"d.event_type",
+		"d.payload",
+		"d.status",
+		"d.attempts",
+		"d.last_error",
+		"d.next_attempt_at",
+		"d.delivered_at",
+		"s.url",
+		"s.secret",
+	).
+		From(tableWebhookDeliveriesName + " d").
+		Join(tableWebhookSubscriptionsName + " s ON s.id = d.subscription_id").
+		Where(squirrel.Eq{"d.status": string(webhooks.DeliveryStatusPending)}).
+		Where(squirrel.LtOrEq{"d.next_attempt_at": now}).
+		OrderBy("d.id").
+		Limit(limit).
+		Suffix("FOR UPDATE OF d SKIP LOCKED").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []deliveryRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	res := make([]webhooks.Delivery, 0, len(rows))
+	for i := range rows {
+		res = append(res, rows[i].ToModel())
+	}
+
+	return res, nil
+}
+
+func (r *WebhooksStorage) UpdateDelivery(ctx context.Context, delivery *webhooks.Delivery) error {
+	const api = "webhooks_storage.UpdateDelivery"
+
+	query := squirrel.Update(tableWebhookDeliveriesName).
+		SetMap(map[string]any{
+			"status":          string(delivery.Status),
+			"attempts":        delivery.Attempts,
+			"last_error":      sql.NullString{String: delivery.LastError, Valid: delivery.LastError != ""},
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    sql.NullTime{Time: delivery.DeliveredAt, Valid: !delivery.DeliveredAt.IsZero()},
+		}).
+		Where(squirrel.Eq{"id": delivery.ID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pkgerrors.Wrap(api, models.ErrNotFound)
+	}
+
+	return nil
+}
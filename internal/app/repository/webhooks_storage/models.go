@@ -0,0 +1,68 @@
+package webhooks_storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+type subscriptionRow struct {
+	ID         pgxuuid.UUID `db:"id"`
+	URL        string       `db:"url"`
+	EventTypes []string     `db:"event_types"`
+	Secret     string       `db:"secret"`
+	CreatedAt  time.Time    `db:"created_at"`
+}
+
+func (r *subscriptionRow) ToModel() webhooks.Subscription {
+	return webhooks.Subscription{
+		ID:         uuid.UUID(r.ID),
+		URL:        r.URL,
+		EventTypes: r.EventTypes,
+		Secret:     r.Secret,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func subscriptionsFromRows(rows []subscriptionRow) []webhooks.Subscription {
+	res := make([]webhooks.Subscription, 0, len(rows))
+	for i := range rows {
+		res = append(res, rows[i].ToModel())
+	}
+	return res
+}
+
+type deliveryRow struct {
+	ID             int64          `db:"id"`
+	SubscriptionID pgxuuid.UUID   `db:"subscription_id"`
+	EventID        string         `db:"event_id"`
+	EventType      string         `db:"event_type"`
+	Payload        []byte         `db:"payload"`
+	Status         string         `db:"status"`
+	Attempts       int32          `db:"attempts"`
+	LastError      sql.NullString `db:"last_error"`
+	NextAttemptAt  time.Time      `db:"next_attempt_at"`
+	DeliveredAt    sql.NullTime   `db:"delivered_at"`
+	URL            string         `db:"url"`
+	Secret         string         `db:"secret"`
+}
+
+func (r *deliveryRow) ToModel() webhooks.Delivery {
+	return webhooks.Delivery{
+		ID:             r.ID,
+		SubscriptionID: uuid.UUID(r.SubscriptionID),
+		EventID:        r.EventID,
+		EventType:      r.EventType,
+		Payload:        r.Payload,
+		Status:         webhooks.DeliveryStatus(r.Status),
+		Attempts:       int(r.Attempts),
+		LastError:      r.LastError.String,
+		NextAttemptAt:  r.NextAttemptAt,
+		DeliveredAt:    r.DeliveredAt.Time,
+		URL:            r.URL,
+		Secret:         r.Secret,
+	}
+}
@@ -0,0 +1,39 @@
+package webhooks_storage
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+var (
+	_ webhooks.Storage = (*WebhooksStorage)(nil)
+)
+
+type WebhooksStorage struct {
+	driver QueryEngineProvider
+}
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+func New(driver QueryEngineProvider) *WebhooksStorage {
+	return &WebhooksStorage{
+		driver: driver,
+	}
+}
+
+const (
+	tableWebhookSubscriptionsName = "webhook_subscriptions"
+	tableWebhookDeliveriesName    = "webhook_deliveries"
+)
+
+var subscriptionColumns = []string{
+	"id",
+	"url",
+	"event_types",
+	"secret",
+	"created_at",
+}
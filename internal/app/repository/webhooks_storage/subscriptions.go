@@ -0,0 +1,78 @@
+package webhooks_storage
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	pgxuuid "github.com/vgarvardt/pgx-google-uuid/v5"
+)
+
+func (r *WebhooksStorage) CreateSubscription(ctx context.Context, sub *webhooks.Subscription) error {
+	const api = "webhooks_storage.CreateSubscription"
+
+	query := squirrel.Insert(tableWebhookSubscriptionsName).
+		Columns(subscriptionColumns...).
+		Values(pgxuuid.UUID(sub.ID), sub.URL, sub.EventTypes, sub.Secret, sub.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (r *WebhooksStorage) ListSubscriptions(ctx context.Context) ([]webhooks.Subscription, error) {
+	const api = "webhooks_storage.ListSubscriptions"
+
+	query := squirrel.Select(subscriptionColumns...).
+		From(tableWebhookSubscriptionsName).
+		OrderBy("created_at", "id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []subscriptionRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return subscriptionsFromRows(rows), nil
+}
+
+func (r *WebhooksStorage) ListSubscriptionsByEventType(ctx context.Context, eventType string) ([]webhooks.Subscription, error) {
+	const api = "webhooks_storage.ListSubscriptionsByEventType"
+
+	query := squirrel.Select(subscriptionColumns...).
+		From(tableWebhookSubscriptionsName).
+		Where("event_types @> ARRAY[?]::text[]", eventType).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	var rows []subscriptionRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return subscriptionsFromRows(rows), nil
+}
+
+func (r *WebhooksStorage) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	const api = "webhooks_storage.DeleteSubscription"
+
+	query := squirrel.Delete(tableWebhookSubscriptionsName).
+		Where(squirrel.Eq{"id": pgxuuid.UUID(id)}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	tag, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pkgerrors.Wrap(api, models.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultAuditLogPageSize = 100
+
+// AuditLog - history of order mutations
+type AuditLog interface {
+	List(ctx context.Context, orderID models.OrderID, afterID int64, limit uint64) ([]models.AuditRecord, error)
+}
+
+func (s *Server) ListOrderAuditLog(ctx context.Context, req *pb.ListOrderAuditLogRequest) (*pb.ListOrderAuditLogResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	orderID := models.OrderID(uuid.MustParse(req.GetOrderId())) // validated
+
+	afterID, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := uint64(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultAuditLogPageSize
+	}
+
+	records, err := s.AuditLog.List(ctx, orderID, afterID, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListOrderAuditLogResponse{
+		Records: make([]*pb.AuditRecord, 0, len(records)),
+	}
+	for i := range records {
+		resp.Records = append(resp.Records, pbAuditRecordFromModelsAuditRecord(&records[i]))
+	}
+	if uint64(len(records)) == pageSize {
+		resp.NextPageToken = encodePageToken(records[len(records)-1].ID)
+	}
+
+	return resp, nil
+}
+
+func pbAuditRecordFromModelsAuditRecord(record *models.AuditRecord) *pb.AuditRecord {
+	changes := make([]*pb.AuditChange, 0, len(record.Changes))
+	for _, change := range record.Changes {
+		changes = append(changes, &pb.AuditChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+
+	return &pb.AuditRecord{
+		Id:        record.ID,
+		OrderId:   record.OrderID.String(),
+		Actor:     record.Actor,
+		Action:    string(record.Action),
+		Changes:   changes,
+		ClientIp:  record.ClientIP,
+		RequestId: record.RequestID,
+		CreatedAt: timestamppb.New(record.CreatedAt),
+	}
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+)
+
+func (s *Server) ConfirmPayment(ctx context.Context, req *pb.ConfirmPaymentRequest) (*pb.ConfirmPaymentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	orderID := models.OrderID(uuid.MustParse(req.GetOrderId())) // validated
+
+	version, err := expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.OMSUsecase.ConfirmPayment(ctx, orderID, models.PaymentID(req.GetPaymentId()), version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfirmPaymentResponse{
+		OrderId: order.ID.String(),
+		Status:  pbOrderStatusFromModelsOrderStatus(order.Status),
+		Version: order.Version,
+	}, nil
+}
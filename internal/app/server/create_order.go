@@ -7,6 +7,8 @@ import (
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
 	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
 	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
@@ -21,9 +23,65 @@ func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*
 		return nil, err
 	}
 
+	return pbCreateOrderResponseFromModelsOrder(order), nil
+}
+
+func pbCreateOrderResponseFromModelsOrder(order *models.Order) *pb.CreateOrderResponse {
 	return &pb.CreateOrderResponse{
-		OrderId: order.ID.String(),
-	}, nil
+		OrderId:      order.ID.String(),
+		Version:      order.Version,
+		Items:        pbItemsFromModelsItems(order.Items),
+		Subtotal:     pbMoneyFromModelsMoney(order.Subtotal),
+		DeliveryCost: pbMoneyFromModelsMoney(order.DeliveryCost),
+		Total:        pbMoneyFromModelsMoney(order.Total),
+		Discount:     pbMoneyFromModelsMoney(order.Discount),
+		Status:       pbOrderStatusFromModelsOrderStatus(order.Status),
+		Payment:      pbPaymentFromModelsPaymentOrderInfo(order.PaymentOrderInfo),
+	}
+}
+
+func pbItemsFromModelsItems(items []models.Item) []*pb.CreateOrderResponse_Item {
+	res := make([]*pb.CreateOrderResponse_Item, 0, len(items))
+	for _, item := range items {
+		res = append(res, &pb.CreateOrderResponse_Item{
+			SkuId:       uint64(item.SKU.ID),
+			Name:        item.SKU.Name,
+			Quantity:    item.Quantity,
+			WarehouseId: uint64(item.WarehouseID),
+			UnitPrice:   pbMoneyFromModelsMoney(item.UnitPrice),
+			Total:       pbMoneyFromModelsMoney(item.Total),
+		})
+	}
+	return res
+}
+
+func pbPaymentFromModelsPaymentOrderInfo(info models.PaymentOrderInfo) *pb.CreateOrderResponse_Payment {
+	return &pb.CreateOrderResponse_Payment{
+		PaymentId:       string(info.PaymentID),
+		ConfirmationUrl: info.PaymentURL,
+		ExpiresAt:       timestamppb.New(info.PaymentExpiresAt),
+	}
+}
+
+func pbOrderStatusFromModelsOrderStatus(status models.OrderStatus) pb.OrderStatus {
+	switch status {
+	case models.OrderStatusAwaitingPayment:
+		return pb.OrderStatus_ORDER_STATUS_AWAITING_PAYMENT
+	case models.OrderStatusPaid:
+		return pb.OrderStatus_ORDER_STATUS_PAID
+	case models.OrderStatusCancelled:
+		return pb.OrderStatus_ORDER_STATUS_CANCELLED
+	default:
+		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+}
+
+func pbMoneyFromModelsMoney(m models.Money) *money.Money {
+	return &money.Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        m.Units,
+		Nanos:        m.Nanos,
+	}
 }
 
 func createOrderInfoFromPbCreateOrderRequest(req *pb.CreateOrderRequest) orders_management_system.CreateOrderInfo {
@@ -34,7 +92,7 @@ func createOrderInfoFromPbCreateOrderRequest(req *pb.CreateOrderRequest) orders_
 				ID: models.SKUID(item.GetId()),
 			},
 			Quantity:    item.GetQuantity(),
-			WarehouseID: models.WarehouseID(item.GetQuantity()),
+			WarehouseID: models.WarehouseID(item.GetWarehouseId()),
 		})
 	}
 
@@ -45,6 +103,7 @@ func createOrderInfoFromPbCreateOrderRequest(req *pb.CreateOrderRequest) orders_
 			DeliveryVariantID: models.DeliveryVariantID(deliveryInfo.GetDeliveryVariantId()),
 			DeliveryDate:      deliveryInfo.GetDeliveryDate().AsTime(),
 		},
-		Items: items,
+		Items:     items,
+		PromoCode: req.GetPromoCode(),
 	}
 }
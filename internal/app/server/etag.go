@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// ifMatchMetadata - If-Match header of REST request, grpc-gateway passes permanent HTTP headers with prefix
+const ifMatchMetadata = "grpcgateway-if-match"
+
+// versioned - response with order version
+type versioned interface {
+	GetVersion() int64
+}
+
+// setETag - sets ETag header of REST responses with order version, so that clients pass it back in If-Match
+func setETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if v, ok := resp.(versioned); ok && v.GetVersion() > 0 {
+		w.Header().Set("ETag", formatETag(v.GetVersion()))
+	}
+	return nil
+}
+
+// formatETag - strong entity tag of order version, e.g. "3"
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// expectedVersion - returns version of the request or, when it is empty, version of If-Match header, 0 - any version
+func expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version != 0 {
+		return version, nil
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, ifMatchMetadata)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	return parseETag(values[0])
+}
+
+// parseETag - parses single entity tag of order version, weak tags are accepted as well, * matches any version
+func parseETag(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "*" {
+		return 0, nil
+	}
+
+	if tag, err := strconv.Unquote(strings.TrimPrefix(value, "W/")); err == nil {
+		if version, err := strconv.ParseInt(tag, 10, 64); err == nil && version > 0 {
+			return version, nil
+		}
+	}
+
+	return 0, &models.ValidationError{
+		Violations: []models.FieldViolation{{
+			Field:       "version",
+			Description: `If-Match must be entity tag of order version, e.g. "3"`,
+		}},
+	}
+}
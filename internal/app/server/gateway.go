@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	middleware_errors "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/errors"
+	middleware_logging "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/logging"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// gatewayMarshaler - JSON representation shared by all REST responses:
+// snake_case field names as declared in proto and zero values are always present.
+var gatewayMarshaler = &runtime.HTTPBodyMarshaler{
+	Marshaler: &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	},
+}
+
+func newGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithForwardResponseOption(middleware_logging.LogHTTPResponse),
+	)
+}
+
+// newGatewayHandler - extracts W3C trace context (traceparent, tracestate) of REST callers
+// and starts server span, in-process handlers receive it with request context.
+// In-process calls bypass logging interceptor of gRPC server, so REST calls are logged by HTTP middleware
+func newGatewayHandler(mux *runtime.ServeMux) http.Handler {
+	handler := middleware_logging.LogHTTPMiddleware(mux)
+
+	return otelhttp.NewHandler(handler, "grpc-gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method // path is in attributes, it is unbounded as span name
+		}),
+	)
+}
+
+// gatewayErrorHandler - in-process gateway calls handlers bypassing interceptors of gRPC server,
+// so domain errors are converted to gRPC statuses here.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var httpStatusErr *runtime.HTTPStatusError
+	if !errors.As(err, &httpStatusErr) {
+		err = middleware_errors.ToStatusError(err)
+	}
+	middleware_logging.SetHTTPError(ctx, err)
+
+	grpcutils.ProblemDetailsErrorHandler(ctx, mux, m, w, r, err)
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// inProcessConn - client connection of the gateway calling unary handlers of the service in-process
+// through interceptors, decoded requests are passed without serialization
+type inProcessConn struct {
+	srv         interface{}
+	methods     map[string]grpc.MethodDesc
+	interceptor grpc.UnaryServerInterceptor
+}
+
+var _ grpc.ClientConnInterface = (*inProcessConn)(nil)
+
+func newInProcessConn(desc *grpc.ServiceDesc, srv interface{}, interceptors ...grpc.UnaryServerInterceptor) *inProcessConn {
+	methods := make(map[string]grpc.MethodDesc, len(desc.Methods))
+	for _, method := range desc.Methods {
+		methods["/"+desc.ServiceName+"/"+method.MethodName] = method
+	}
+
+	return &inProcessConn{
+		srv:         srv,
+		methods:     methods,
+		interceptor: chainUnaryInterceptors(interceptors),
+	}
+}
+
+// Invoke - calls handler of method with metadata of the gateway as incoming one,
+// headers and trailers set by handler are returned by grpc.Header and grpc.Trailer options
+func (c *inProcessConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	desc, ok := c.methods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "method %s is not implemented", method)
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	stream := &inProcessTransportStream{method: method}
+	ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+	resp, err := desc.Handler(c.srv, ctx, func(req interface{}) error {
+		proto.Merge(req.(proto.Message), args.(proto.Message))
+		return nil
+	}, c.interceptor)
+
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case grpc.HeaderCallOption:
+			*o.HeaderAddr = stream.header
+		case grpc.TrailerCallOption:
+			*o.TrailerAddr = stream.trailer
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	proto.Merge(reply.(proto.Message), resp.(proto.Message))
+
+	return nil
+}
+
+// NewStream - streams are not supported in-process, the same way as by in-process handlers of the gateway
+func (c *inProcessConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming calls are not supported in the in-process transport")
+}
+
+// inProcessTransportStream - collects headers and trailers set by handler
+type inProcessTransportStream struct {
+	method  string
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *inProcessTransportStream) Method() string {
+	return s.method
+}
+
+func (s *inProcessTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *inProcessTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *inProcessTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+// chainUnaryInterceptors - returns interceptor calling interceptors in order, the first one is the outermost
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i > 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return interceptors[0](ctx, req, info, next)
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetLogLevel(_ context.Context, _ *pb.GetLogLevelRequest) (*pb.GetLogLevelResponse, error) {
+	return &pb.GetLogLevelResponse{
+		Level: pbLogLevelFromZapcoreLevel(logger.Level()),
+		Debug: pbDebugLoggingFromLoggerDebugWindow(logger.GetDebugWindow()),
+	}, nil
+}
+
+func (s *Server) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	debugTargets := len(req.GetDebugMethods()) > 0 || len(req.GetDebugUserIds()) > 0
+	if debugTargets && req.GetDebugDuration() == nil {
+		return nil, &models.ValidationError{
+			Violations: []models.FieldViolation{{
+				Field:       "debug_duration",
+				Description: "required when debug_methods or debug_user_ids are set",
+			}},
+		}
+	}
+
+	if req.GetLevel() != pb.LogLevel_LOG_LEVEL_UNSPECIFIED {
+		logger.SetLevel(zapcoreLevelFromPbLogLevel(req.GetLevel()))
+	}
+
+	// window is replaced on every call, request without targets turns it off
+	window := logger.DebugWindow{}
+	if debugTargets {
+		window = logger.DebugWindow{
+			Methods: req.GetDebugMethods(),
+			UserIDs: req.GetDebugUserIds(),
+			Until:   time.Now().Add(req.GetDebugDuration().AsDuration()),
+		}
+	}
+	logger.SetDebugWindow(window)
+
+	logger.WarnKV(ctx, "log level is changed",
+		"level", logger.Level().String(),
+		"debug_methods", window.Methods,
+		"debug_user_ids", window.UserIDs,
+		"debug_until", window.Until,
+	)
+
+	return &pb.SetLogLevelResponse{
+		Level: pbLogLevelFromZapcoreLevel(logger.Level()),
+		Debug: pbDebugLoggingFromLoggerDebugWindow(logger.GetDebugWindow()),
+	}, nil
+}
+
+func pbLogLevelFromZapcoreLevel(level zapcore.Level) pb.LogLevel {
+	switch level {
+	case zapcore.DebugLevel:
+		return pb.LogLevel_LOG_LEVEL_DEBUG
+	case zapcore.InfoLevel:
+		return pb.LogLevel_LOG_LEVEL_INFO
+	case zapcore.WarnLevel:
+		return pb.LogLevel_LOG_LEVEL_WARN
+	case zapcore.ErrorLevel:
+		return pb.LogLevel_LOG_LEVEL_ERROR
+	default:
+		return pb.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+}
+
+func zapcoreLevelFromPbLogLevel(level pb.LogLevel) zapcore.Level {
+	switch level {
+	case pb.LogLevel_LOG_LEVEL_DEBUG:
+		return zapcore.DebugLevel
+	case pb.LogLevel_LOG_LEVEL_WARN:
+		return zapcore.WarnLevel
+	case pb.LogLevel_LOG_LEVEL_ERROR:
+		return zapcore.ErrorLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
+
+func pbDebugLoggingFromLoggerDebugWindow(w logger.DebugWindow) *pb.DebugLogging {
+	if w.Until.IsZero() {
+		return nil
+	}
+	return &pb.DebugLogging{
+		Methods:   w.Methods,
+		UserIds:   w.UserIDs,
+		ExpiresAt: timestamppb.New(w.Until),
+	}
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+const (
+	orderEventsPath = "/api/v1/orders/{order_id}/events"
+
+	// sseHeartbeatInterval - comment lines keep idle connections alive behind proxies
+	sseHeartbeatInterval = 15 * time.Second
+	// sseRetry - reconnection delay suggested to EventSource clients, ms
+	sseRetry = 3000
+)
+
+// orderEventsHandler - streams order status transitions as text/event-stream.
+// Event id is the id in order status history, so reconnecting client with Last-Event-ID header
+// receives transitions it missed.
+func (s *Server) orderEventsHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+
+		fail := func(err error) {
+			gatewayErrorHandler(ctx, mux, gatewayMarshaler, w, r, err)
+		}
+
+		req := &pb.WatchOrderRequest{OrderId: pathParams["order_id"]}
+		if err := s.validator.Validate(req); err != nil {
+			fail(grpcutils.RPCValidationError(err))
+			return
+		}
+		orderID := models.OrderID(uuid.MustParse(req.GetOrderId())) // validated
+
+		lastEventID, err := parseLastEventID(r)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		if _, err = s.OMSUsecase.GetOrder(ctx, orderID); err != nil {
+			fail(err)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			fail(fmt.Errorf("server: streaming is not supported"))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		var mu sync.Mutex
+		write := func(format string, args ...any) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if _, err := fmt.Fprintf(w, format, args...); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		}
+
+		if err = write("retry: %d\n\n", sseRetry); err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go func() {
+			ticker := time.NewTicker(sseHeartbeatInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := write(": ping\n\n"); err != nil {
+						cancel()
+						return
+					}
+				}
+			}
+		}()
+
+		err = s.OMSUsecase.StreamOrderEvents(ctx, orderID, lastEventID, func(event models.OrderEvent) error {
+			data, err := gatewayMarshaler.Marshal(pbWatchOrderResponseFromModelsOrderEvent(event))
+			if err != nil {
+				return err
+			}
+			return write("id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+		})
+		if err != nil {
+			// headers are already sent, client reconnects with Last-Event-ID
+			logger.WarnKV(ctx, "server: order events stream is interrupted",
+				"order_id", orderID.String(),
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+// parseLastEventID - reads Last-Event-ID header set by EventSource on reconnect,
+// last_event_id query parameter is accepted for clients unable to set headers
+func parseLastEventID(r *http.Request) (int64, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, &models.ValidationError{
+			Violations: []models.FieldViolation{{
+				Field:       "last_event_id",
+				Description: "must be non-negative integer",
+			}},
+		}
+	}
+
+	return id, nil
+}
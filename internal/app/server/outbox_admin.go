@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultOutboxPageSize = 100
+
+// OutboxAdmin - manual operations over outbox messages
+type OutboxAdmin interface {
+	ListDead(ctx context.Context, afterID int64, limit uint64) ([]outbox.Message, error)
+	GetMessage(ctx context.Context, id int64) (*outbox.Message, error)
+	Requeue(ctx context.Context, id int64) (*outbox.Message, error)
+}
+
+func (s *Server) ListDeadOutboxMessages(ctx context.Context, req *pb.ListDeadOutboxMessagesRequest) (*pb.ListDeadOutboxMessagesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	afterID, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := uint64(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultOutboxPageSize
+	}
+
+	messages, err := s.OutboxAdmin.ListDead(ctx, afterID, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDeadOutboxMessagesResponse{
+		Messages: make([]*pb.OutboxMessage, 0, len(messages)),
+	}
+	for i := range messages {
+		resp.Messages = append(resp.Messages, pbOutboxMessageFromOutboxMessage(&messages[i]))
+	}
+	if uint64(len(messages)) == pageSize {
+		resp.NextPageToken = encodePageToken(messages[len(messages)-1].ID)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetOutboxMessage(ctx context.Context, req *pb.GetOutboxMessageRequest) (*pb.GetOutboxMessageResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	msg, err := s.OutboxAdmin.GetMessage(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOutboxMessageResponse{
+		Message: pbOutboxMessageFromOutboxMessage(msg),
+	}, nil
+}
+
+func (s *Server) RequeueOutboxMessage(ctx context.Context, req *pb.RequeueOutboxMessageRequest) (*pb.RequeueOutboxMessageResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	msg, err := s.OutboxAdmin.Requeue(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequeueOutboxMessageResponse{
+		Message: pbOutboxMessageFromOutboxMessage(msg),
+	}, nil
+}
+
+// encodePageToken - page token is opaque for clients, it holds id of the last returned item
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		var id int64
+		if id, err = strconv.ParseInt(string(raw), 10, 64); err == nil {
+			return id, nil
+		}
+	}
+
+	return 0, &models.ValidationError{
+		Violations: []models.FieldViolation{{
+			Field:       "page_token",
+			Description: "invalid page token",
+		}},
+	}
+}
+
+func pbOutboxMessageFromOutboxMessage(msg *outbox.Message) *pb.OutboxMessage {
+	res := &pb.OutboxMessage{
+		Id:            msg.ID,
+		AggregateId:   msg.AggregateID,
+		Sequence:      msg.Sequence,
+		EventType:     msg.EventType,
+		Status:        pbOutboxMessageStatusFromOutboxStatus(msg.Status),
+		Attempts:      int32(msg.Attempts),
+		LastError:     msg.LastError,
+		CreatedAt:     timestamppb.New(msg.CreatedAt),
+		NextAttemptAt: timestamppb.New(msg.NextAttemptAt),
+	}
+	if !msg.SentAt.IsZero() {
+		res.SentAt = timestamppb.New(msg.SentAt)
+	}
+	return res
+}
+
+func pbOutboxMessageStatusFromOutboxStatus(status outbox.Status) pb.OutboxMessageStatus {
+	switch status {
+	case outbox.StatusPending:
+		return pb.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_PENDING
+	case outbox.StatusSent:
+		return pb.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_SENT
+	case outbox.StatusDead:
+		return pb.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_DEAD
+	default:
+		return pb.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_UNSPECIFIED
+	}
+}
@@ -9,24 +9,32 @@ import (
 	"time"
 
 	"github.com/bufbuild/protovalidate-go"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
 	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/stats"
 )
 
 type Config struct {
 	GRPCPort        string
 	GRPCGatewayPort string
 
-	ChainUnaryInterceptors []grpc.UnaryServerInterceptor
-	UnaryInterceptors      []grpc.UnaryServerInterceptor
+	ChainUnaryInterceptors  []grpc.UnaryServerInterceptor
+	UnaryInterceptors       []grpc.UnaryServerInterceptor
+	ChainStreamInterceptors []grpc.StreamServerInterceptor
+	// GatewayUnaryInterceptors - interceptors of REST calls, in-process gateway bypasses the ones of gRPC server
+	GatewayUnaryInterceptors []grpc.UnaryServerInterceptor
+	// StatsHandler - optional, e.g. OpenTelemetry instrumentation starting span of every RPC
+	StatsHandler stats.Handler
 }
 
 type Deps struct {
-	OMSUsecase orders_management_system.UsecaseInterface
+	OMSUsecase  orders_management_system.UsecaseInterface
+	OutboxAdmin OutboxAdmin
+	Webhooks    Webhooks
+	AuditLog    AuditLog
 }
 
 type Server struct {
@@ -56,6 +64,16 @@ func New(ctx context.Context, cfg Config, d Deps) (*Server, error) {
 			protovalidate.WithDisableLazy(true),
 			protovalidate.WithMessages(
 				&pb.CreateOrderRequest{},
+				&pb.UpdateOrderRequest{},
+				&pb.ConfirmPaymentRequest{},
+				&pb.WatchOrderRequest{},
+				&pb.ListDeadOutboxMessagesRequest{},
+				&pb.GetOutboxMessageRequest{},
+				&pb.RequeueOutboxMessageRequest{},
+				&pb.RegisterWebhookRequest{},
+				&pb.DeleteWebhookRequest{},
+				&pb.SetLogLevelRequest{},
+				&pb.ListOrderAuditLogRequest{},
 			),
 		)
 		if err != nil {
@@ -68,7 +86,11 @@ func New(ctx context.Context, cfg Config, d Deps) (*Server, error) {
 		grpcServerOptions := unaryInterceptorsToGrpcServerOptions(cfg.UnaryInterceptors...)
 		grpcServerOptions = append(grpcServerOptions,
 			grpc.ChainUnaryInterceptor(cfg.ChainUnaryInterceptors...),
+			grpc.ChainStreamInterceptor(cfg.ChainStreamInterceptors...),
 		)
+		if cfg.StatsHandler != nil {
+			grpcServerOptions = append(grpcServerOptions, grpc.StatsHandler(cfg.StatsHandler))
+		}
 
 		grpcServer := grpc.NewServer(grpcServerOptions...)
 		pb.RegisterOrdersManagementSystemServiceServer(grpcServer, srv)
@@ -85,12 +107,16 @@ func New(ctx context.Context, cfg Config, d Deps) (*Server, error) {
 	}
 
 	{
-		mux := runtime.NewServeMux()
-		if err := pb.RegisterOrdersManagementSystemServiceHandlerServer(ctx, mux, srv); err != nil {
+		mux := newGatewayMux()
+		conn := newInProcessConn(&pb.OrdersManagementSystemService_ServiceDesc, srv, cfg.GatewayUnaryInterceptors...)
+		if err := pb.RegisterOrdersManagementSystemServiceHandlerClient(ctx, mux, pb.NewOrdersManagementSystemServiceClient(conn)); err != nil {
 			return nil, fmt.Errorf("server: failed to register handler: %v", err)
 		}
+		if err := mux.HandlePath(http.MethodGet, orderEventsPath, srv.orderEventsHandler(mux)); err != nil {
+			return nil, fmt.Errorf("server: failed to register order events handler: %v", err)
+		}
 
-		httpServer := &http.Server{Handler: mux}
+		httpServer := &http.Server{Handler: newGatewayHandler(mux)}
 
 		lis, err := net.Listen("tcp", cfg.GRPCGatewayPort)
 		if err != nil {
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.UpdateOrderResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	orderID := models.OrderID(uuid.MustParse(req.GetOrderId())) // validated
+
+	version, err := expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.OMSUsecase.UpdateOrder(ctx, orderID, updateOrderInfoFromPbUpdateOrderRequest(req), version)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbUpdateOrderResponseFromModelsOrder(order), nil
+}
+
+func updateOrderInfoFromPbUpdateOrderRequest(req *pb.UpdateOrderRequest) orders_management_system.UpdateOrderInfo {
+	items := make([]models.Item, 0, len(req.GetOrder().GetItems()))
+	for _, item := range req.GetOrder().GetItems() {
+		items = append(items, models.Item{
+			SKU: models.SKU{
+				ID: models.SKUID(item.GetId()),
+			},
+			Quantity:    item.GetQuantity(),
+			WarehouseID: models.WarehouseID(item.GetWarehouseId()),
+		})
+	}
+
+	deliveryInfo := req.GetOrder().GetDeliveryInfo()
+
+	return orders_management_system.UpdateOrderInfo{
+		Paths: req.GetUpdateMask().GetPaths(),
+		Items: items,
+		DeliveryOrderInfo: models.DeliveryOrderInfo{
+			DeliveryVariantID: models.DeliveryVariantID(deliveryInfo.GetDeliveryVariantId()),
+			DeliveryDate:      deliveryInfo.GetDeliveryDate().AsTime(),
+		},
+	}
+}
+
+func pbUpdateOrderResponseFromModelsOrder(order *models.Order) *pb.UpdateOrderResponse {
+	return &pb.UpdateOrderResponse{
+		OrderId:           order.ID.String(),
+		Items:             pbItemsFromModelsItems(order.Items),
+		DeliveryVariantId: uint64(order.DeliveryVariantID),
+		DeliveryDate:      timestamppb.New(order.DeliveryDate),
+		Subtotal:          pbMoneyFromModelsMoney(order.Subtotal),
+		DeliveryCost:      pbMoneyFromModelsMoney(order.DeliveryCost),
+		Discount:          pbMoneyFromModelsMoney(order.Discount),
+		Total:             pbMoneyFromModelsMoney(order.Total),
+		Status:            pbOrderStatusFromModelsOrderStatus(order.Status),
+		Payment:           pbPaymentFromModelsPaymentOrderInfo(order.PaymentOrderInfo),
+		Version:           order.Version,
+	}
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) WatchOrder(req *pb.WatchOrderRequest, stream pb.OrdersManagementSystemService_WatchOrderServer) error {
+	if err := s.validator.Validate(req); err != nil {
+		return grpcutils.RPCValidationError(err)
+	}
+
+	orderID := models.OrderID(uuid.MustParse(req.GetOrderId())) // validated
+
+	return s.OMSUsecase.WatchOrder(stream.Context(), orderID, func(event models.OrderEvent) error {
+		return stream.Send(pbWatchOrderResponseFromModelsOrderEvent(event))
+	})
+}
+
+func pbWatchOrderResponseFromModelsOrderEvent(event models.OrderEvent) *pb.WatchOrderResponse {
+	resp := &pb.WatchOrderResponse{
+		OrderId:   event.OrderID.String(),
+		Status:    pbOrderStatusFromModelsOrderStatus(event.Status),
+		EventType: string(event.Type),
+	}
+	if !event.OccurredAt.IsZero() {
+		resp.OccurredAt = timestamppb.New(event.OccurredAt)
+	}
+	return resp
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/webhooks"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Webhooks - management of webhook subscriptions
+type Webhooks interface {
+	Register(ctx context.Context, url string, eventTypes []string) (*webhooks.Subscription, error)
+	List(ctx context.Context) ([]webhooks.Subscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+func (s *Server) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	sub, err := s.Webhooks.Register(ctx, req.GetUrl(), req.GetEventTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterWebhookResponse{
+		Subscription: pbWebhookSubscriptionFromWebhooksSubscription(sub),
+		Secret:       sub.Secret,
+	}, nil
+}
+
+func (s *Server) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	subs, err := s.Webhooks.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListWebhooksResponse{
+		Subscriptions: make([]*pb.WebhookSubscription, 0, len(subs)),
+	}
+	for i := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, pbWebhookSubscriptionFromWebhooksSubscription(&subs[i]))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	id := uuid.MustParse(req.GetId()) // validated
+
+	if err := s.Webhooks.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+// pbWebhookSubscriptionFromWebhooksSubscription - secret is never returned except on registration
+func pbWebhookSubscriptionFromWebhooksSubscription(sub *webhooks.Subscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:         sub.ID.String(),
+		Url:        sub.URL,
+		EventTypes: sub.EventTypes,
+		CreatedAt:  timestamppb.New(sub.CreatedAt),
+	}
+}
@@ -0,0 +1,103 @@
+package business_rules
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule - business rule of an order.
+// Expression is a CEL expression evaluated to bool, false means the rule is violated.
+//
+// Available variables:
+//
+//	user_id             uint
+//	items               list(map(string, uint)) - keys: sku_id, quantity, warehouse_id
+//	skus                map(uint, uint)         - total quantity by sku_id
+//	delivery_variant_id uint
+//	delivery_date       google.protobuf.Timestamp
+//	now                 google.protobuf.Timestamp
+type Rule struct {
+	Name       string `yaml:"name"`
+	Field      string `yaml:"field"`
+	Expression string `yaml:"expression"`
+	Message    string `yaml:"message"`
+}
+
+type Config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+type compiledRule struct {
+	Rule
+	program cel.Program
+}
+
+type Engine struct {
+	rules []compiledRule
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.BusinessRules = (*Engine)(nil)
+
+// New - returns engine with compiled rules
+func New(cfg Config) (*Engine, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, fmt.Errorf("business_rules: failed to create env: %w", err)
+	}
+
+	rules := make([]compiledRule, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		ast, iss := env.Compile(rule.Expression)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("business_rules: rule %q: %w", rule.Name, iss.Err())
+		}
+		if !ast.OutputType().IsExactType(cel.BoolType) {
+			return nil, fmt.Errorf("business_rules: rule %q: expression must return bool, got %s", rule.Name, ast.OutputType())
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("business_rules: rule %q: %w", rule.Name, err)
+		}
+
+		rules = append(rules, compiledRule{
+			Rule:    rule,
+			program: program,
+		})
+	}
+
+	return &Engine{
+		rules: rules,
+	}, nil
+}
+
+// NewFromFile - returns engine with rules loaded from YAML file
+func NewFromFile(path string) (*Engine, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("business_rules: failed to read rules file: %w", err)
+	}
+
+	var cfg Config
+	if err = yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("business_rules: failed to parse rules file: %w", err)
+	}
+
+	return New(cfg)
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("user_id", cel.UintType),
+		cel.Variable("items", cel.ListType(cel.MapType(cel.StringType, cel.UintType))),
+		cel.Variable("skus", cel.MapType(cel.UintType, cel.UintType)),
+		cel.Variable("delivery_variant_id", cel.UintType),
+		cel.Variable("delivery_date", cel.TimestampType),
+		cel.Variable("now", cel.TimestampType),
+	)
+}
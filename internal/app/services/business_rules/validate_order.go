@@ -0,0 +1,66 @@
+package business_rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+func (e *Engine) ValidateOrder(_ context.Context, order *models.Order) error {
+	const api = "business_rules.ValidateOrder"
+
+	if len(e.rules) == 0 {
+		return nil
+	}
+
+	vars := activationFromOrder(order)
+
+	var violations []models.FieldViolation
+	for _, rule := range e.rules {
+		out, _, err := rule.program.Eval(vars)
+		if err != nil {
+			return fmt.Errorf("%s: rule %q: %w", api, rule.Name, err)
+		}
+
+		ok, isBool := out.Value().(bool)
+		if !isBool {
+			return fmt.Errorf("%s: rule %q: unexpected result type %s", api, rule.Name, out.Type())
+		}
+		if !ok {
+			violations = append(violations, models.FieldViolation{
+				Field:       rule.Field,
+				Description: rule.Message,
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &models.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+func activationFromOrder(order *models.Order) map[string]any {
+	items := make([]map[string]uint64, 0, len(order.Items))
+	skus := make(map[uint64]uint64, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, map[string]uint64{
+			"sku_id":       uint64(item.SKU.ID),
+			"quantity":     uint64(item.Quantity),
+			"warehouse_id": uint64(item.WarehouseID),
+		})
+		skus[uint64(item.SKU.ID)] += uint64(item.Quantity)
+	}
+
+	return map[string]any{
+		"user_id":             uint64(order.UserID),
+		"items":               items,
+		"skus":                skus,
+		"delivery_variant_id": uint64(order.DeliveryVariantID),
+		"delivery_date":       order.DeliveryDate,
+		"now":                 time.Now(),
+	}
+}
@@ -0,0 +1,116 @@
+//go:build test
+
+package business_rules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_ValidateOrder(t *testing.T) {
+	engine, err := New(Config{
+		Rules: []Rule{
+			{
+				Name:       "max_distinct_skus",
+				Field:      "items",
+				Expression: "size(skus) <= 1",
+				Message:    "too many SKUs",
+			},
+			{
+				Name:       "max_quantity_per_sku",
+				Field:      "items.quantity",
+				Expression: "skus.all(id, skus[id] <= 10u)",
+				Message:    "too many items",
+			},
+			{
+				Name:       "warehouse_delivery_variants",
+				Field:      "delivery_info.delivery_variant_id",
+				Expression: "!items.exists(i, i.warehouse_id == 1u) || delivery_variant_id in [1u, 2u]",
+				Message:    "delivery variant is not available",
+			},
+			{
+				Name:       "max_delivery_horizon",
+				Field:      "delivery_info.delivery_date",
+				Expression: `delivery_date - now <= duration("240h")`,
+				Message:    "delivery date is too far",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		order *models.Order
+		want  []models.FieldViolation
+	}{
+		{
+			name: "Test 1. Positive.",
+			order: &models.Order{
+				UserID: 1,
+				Items: []models.Item{
+					{SKU: models.SKU{ID: 1}, Quantity: 6, WarehouseID: 1},
+					{SKU: models.SKU{ID: 1}, Quantity: 4, WarehouseID: 2},
+				},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 2,
+					DeliveryDate:      time.Now().Add(24 * time.Hour),
+				},
+			},
+		},
+		{
+			name: "Test 2. Negative. All rules are violated.",
+			order: &models.Order{
+				UserID: 1,
+				Items: []models.Item{
+					{SKU: models.SKU{ID: 1}, Quantity: 11, WarehouseID: 1},
+					{SKU: models.SKU{ID: 2}, Quantity: 1, WarehouseID: 2},
+				},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 3,
+					DeliveryDate:      time.Now().Add(30 * 24 * time.Hour),
+				},
+			},
+			want: []models.FieldViolation{
+				{Field: "items", Description: "too many SKUs"},
+				{Field: "items.quantity", Description: "too many items"},
+				{Field: "delivery_info.delivery_variant_id", Description: "delivery variant is not available"},
+				{Field: "delivery_info.delivery_date", Description: "delivery date is too far"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := engine.ValidateOrder(context.Background(), tt.order)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *models.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.want, validationErr.Violations)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Test 1. Negative. Expression does not return bool.", func(t *testing.T) {
+		_, err := New(Config{Rules: []Rule{{Name: "bad", Expression: "size(items)"}}})
+		assert.Error(t, err)
+	})
+
+	t.Run("Test 2. Negative. Unknown variable.", func(t *testing.T) {
+		_, err := New(Config{Rules: []Rule{{Name: "bad", Expression: "price > 0"}}})
+		assert.Error(t, err)
+	})
+
+	t.Run("Test 3. Positive. Rules file.", func(t *testing.T) {
+		_, err := NewFromFile("../../../../business_rules.yaml")
+		assert.NoError(t, err)
+	})
+}
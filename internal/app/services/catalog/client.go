@@ -0,0 +1,29 @@
+package catalog
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Client - HTTP client of the catalog service
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.Catalog = (*Client)(nil)
+
+// NewClient - returns catalog service adapter
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
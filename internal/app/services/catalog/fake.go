@@ -0,0 +1,49 @@
+package catalog
+
+import (
+	"context"
+	"sync"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Fake - in-memory catalog for local runs and tests
+type Fake struct {
+	mu   sync.RWMutex
+	skus map[models.SKUID]models.CatalogSKU
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.Catalog = (*Fake)(nil)
+
+func NewFake(skus ...models.CatalogSKU) *Fake {
+	f := &Fake{
+		skus: make(map[models.SKUID]models.CatalogSKU, len(skus)),
+	}
+	for _, sku := range skus {
+		f.skus[sku.ID] = sku
+	}
+	return f
+}
+
+func (f *Fake) GetSKUs(_ context.Context, ids []models.SKUID) ([]models.CatalogSKU, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	skus := make([]models.CatalogSKU, 0, len(ids))
+	for _, id := range ids {
+		if sku, ok := f.skus[id]; ok {
+			skus = append(skus, sku)
+		}
+	}
+	return skus, nil
+}
+
+// SetSKU - adds or replaces SKU card
+func (f *Fake) SetSKU(sku models.CatalogSKU) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.skus[sku.ID] = sku
+}
@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetSKUs - batch fetches SKU cards, unknown SKUs are absent in the result
+func (c *Client) GetSKUs(ctx context.Context, ids []models.SKUID) ([]models.CatalogSKU, error) {
+	const api = "catalog.GetSKUs"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int("sku_count", len(ids)),
+		),
+	)
+	defer span.End()
+
+	reqBody := getSKUsRequest{SKUIDs: make([]uint64, 0, len(ids))}
+	for _, id := range ids {
+		reqBody.SKUIDs = append(reqBody.SKUIDs, uint64(id))
+	}
+
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		c.baseURL+"/api/v1/skus:batchGet", bytes.NewReader(body))
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+
+	var respBody getSKUsResponse
+	if err = json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	skus := make([]models.CatalogSKU, 0, len(respBody.SKUs))
+	for i := range respBody.SKUs {
+		skus = append(skus, respBody.SKUs[i].toModel())
+	}
+
+	return skus, nil
+}
@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+type getSKUsRequest struct {
+	SKUIDs []uint64 `json:"sku_ids"`
+}
+
+type getSKUsResponse struct {
+	SKUs []sku `json:"skus"`
+}
+
+type money struct {
+	CurrencyCode string `json:"currency_code"`
+	Units        int64  `json:"units,string"`
+	Nanos        int32  `json:"nanos"`
+}
+
+type sku struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	Price     money  `json:"price"`
+	Available bool   `json:"available"`
+}
+
+func (s *sku) toModel() models.CatalogSKU {
+	return models.CatalogSKU{
+		SKU: models.SKU{
+			ID:   models.SKUID(s.ID),
+			Name: s.Name,
+			Price: models.Money{
+				CurrencyCode: s.Price.CurrencyCode,
+				Units:        s.Price.Units,
+				Nanos:        s.Price.Nanos,
+			},
+		},
+		Available: s.Available,
+	}
+}
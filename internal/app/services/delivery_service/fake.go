@@ -0,0 +1,131 @@
+package delivery_service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Fake - in-memory delivery service for local runs and tests
+type Fake struct {
+	mu           sync.RWMutex
+	variants     map[models.DeliveryVariantID]models.DeliveryVariant
+	reservations []FakeReservation
+	released     map[string]struct{}
+}
+
+type FakeReservation struct {
+	UserID    models.UserID
+	VariantID models.DeliveryVariantID
+	SlotID    models.DeliverySlotID
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.DeliveryService = (*Fake)(nil)
+
+func NewFake(variants ...models.DeliveryVariant) *Fake {
+	f := &Fake{
+		variants: make(map[models.DeliveryVariantID]models.DeliveryVariant, len(variants)),
+		released: make(map[string]struct{}),
+	}
+	for _, v := range variants {
+		f.variants[v.ID] = v
+	}
+	return f
+}
+
+func (f *Fake) GetDeliveryVariant(_ context.Context, id models.DeliveryVariantID) (*models.DeliveryVariant, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	v, ok := f.variants[id]
+	if !ok {
+		return nil, models.ErrNotFound
+	}
+	return &v, nil
+}
+
+func (f *Fake) ReserveDeliverySlot(
+	_ context.Context,
+	userID models.UserID,
+	variantID models.DeliveryVariantID,
+	slotID models.DeliverySlotID,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, ok := f.variants[variantID]
+	if !ok {
+		return models.ErrNotFound
+	}
+
+	for _, slot := range v.Slots {
+		if slot.ID != slotID {
+			continue
+		}
+		if !slot.Available {
+			return models.ErrDeliverySlotUnavailable
+		}
+
+		f.reservations = append(f.reservations, FakeReservation{
+			UserID:    userID,
+			VariantID: variantID,
+			SlotID:    slotID,
+		})
+		return nil
+	}
+
+	return models.ErrNotFound
+}
+
+func (f *Fake) ReleaseDeliverySlot(
+	_ context.Context,
+	key string,
+	userID models.UserID,
+	variantID models.DeliveryVariantID,
+	slotID models.DeliverySlotID,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.released[key]; ok {
+		return nil
+	}
+	f.released[key] = struct{}{}
+
+	for i, r := range f.reservations {
+		if r.UserID == userID && r.VariantID == variantID && r.SlotID == slotID {
+			f.reservations = append(f.reservations[:i], f.reservations[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// Reservations - returns reserved slots
+func (f *Fake) Reservations() []FakeReservation {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return append([]FakeReservation(nil), f.reservations...)
+}
+
+// DailySlots - returns available slots from 09:00 to 21:00 UTC for the given number of days starting from the day of start
+func DailySlots(start time.Time, days int) []models.DeliverySlot {
+	day := start.UTC().Truncate(24 * time.Hour)
+
+	slots := make([]models.DeliverySlot, 0, days)
+	for i := 0; i < days; i++ {
+		from := day.AddDate(0, 0, i).Add(9 * time.Hour)
+		slots = append(slots, models.DeliverySlot{
+			ID:        models.DeliverySlotID(from.Unix()),
+			From:      from,
+			To:        from.Add(12 * time.Hour),
+			Available: true,
+		})
+	}
+	return slots
+}
@@ -0,0 +1,53 @@
+package delivery_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (c *Client) GetDeliveryVariant(ctx context.Context, id models.DeliveryVariantID) (*models.DeliveryVariant, error) {
+	const api = "delivery_service.GetDeliveryVariant"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("delivery_variant_id", int64(id)),
+		),
+	)
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/api/v1/delivery_variants/%d", c.baseURL, id), nil)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, pkgerrors.Wrap(api, models.ErrNotFound)
+	default:
+		return nil, fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+
+	var variant deliveryVariant
+	if err = json.NewDecoder(resp.Body).Decode(&variant); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return variant.toModel(), nil
+}
@@ -0,0 +1,48 @@
+package delivery_service
+
+import (
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+type deliveryVariant struct {
+	ID           uint64         `json:"id"`
+	WarehouseIDs []uint64       `json:"warehouse_ids"`
+	Slots        []deliverySlot `json:"slots"`
+}
+
+type deliverySlot struct {
+	ID        uint64    `json:"id"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	Available bool      `json:"available"`
+}
+
+type deliverySlotReservationRequest struct {
+	UserID            uint64 `json:"user_id"`
+	DeliveryVariantID uint64 `json:"delivery_variant_id"`
+}
+
+func (v *deliveryVariant) toModel() *models.DeliveryVariant {
+	warehouseIDs := make([]models.WarehouseID, 0, len(v.WarehouseIDs))
+	for _, id := range v.WarehouseIDs {
+		warehouseIDs = append(warehouseIDs, models.WarehouseID(id))
+	}
+
+	slots := make([]models.DeliverySlot, 0, len(v.Slots))
+	for _, slot := range v.Slots {
+		slots = append(slots, models.DeliverySlot{
+			ID:        models.DeliverySlotID(slot.ID),
+			From:      slot.From,
+			To:        slot.To,
+			Available: slot.Available,
+		})
+	}
+
+	return &models.DeliveryVariant{
+		ID:           models.DeliveryVariantID(v.ID),
+		WarehouseIDs: warehouseIDs,
+		Slots:        slots,
+	}
+}
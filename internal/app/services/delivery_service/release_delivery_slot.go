@@ -0,0 +1,64 @@
+package delivery_service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReleaseDeliverySlot - cancels reservation of the slot, reservation which does not exist is already released
+func (c *Client) ReleaseDeliverySlot(
+	ctx context.Context,
+	key string,
+	userID models.UserID,
+	variantID models.DeliveryVariantID,
+	slotID models.DeliverySlotID,
+) error {
+	const api = "delivery_service.ReleaseDeliverySlot"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("user_id", int64(userID)),
+			attribute.Int64("delivery_slot_id", int64(slotID)),
+		),
+	)
+	defer span.End()
+
+	body, err := json.Marshal(deliverySlotReservationRequest{
+		UserID:            uint64(userID),
+		DeliveryVariantID: uint64(variantID),
+	})
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/api/v1/delivery_slots/%d/reservations:release", c.baseURL, slotID), bytes.NewReader(body))
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", key)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+}
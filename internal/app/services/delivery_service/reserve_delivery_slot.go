@@ -0,0 +1,65 @@
+package delivery_service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (c *Client) ReserveDeliverySlot(
+	ctx context.Context,
+	userID models.UserID,
+	variantID models.DeliveryVariantID,
+	slotID models.DeliverySlotID,
+) error {
+	const api = "delivery_service.ReserveDeliverySlot"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("user_id", int64(userID)),
+			attribute.Int64("delivery_slot_id", int64(slotID)),
+		),
+	)
+	defer span.End()
+
+	body, err := json.Marshal(deliverySlotReservationRequest{
+		UserID:            uint64(userID),
+		DeliveryVariantID: uint64(variantID),
+	})
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/api/v1/delivery_slots/%d/reservations", c.baseURL, slotID), bytes.NewReader(body))
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return pkgerrors.Wrap(api, models.ErrNotFound)
+	case http.StatusConflict:
+		return pkgerrors.Wrap(api, models.ErrDeliverySlotUnavailable)
+	default:
+		return fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+}
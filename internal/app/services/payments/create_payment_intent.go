@@ -0,0 +1,58 @@
+package payments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (c *Client) CreatePaymentIntent(ctx context.Context, key string, order *models.Order, expiresAt time.Time) (*models.PaymentIntent, error) {
+	const api = "payments.CreatePaymentIntent"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("order_id", order.ID.String()),
+		),
+	)
+	defer span.End()
+
+	body, err := json.Marshal(newCreatePaymentIntentRequest(order, expiresAt))
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		c.baseURL+"/api/v1/payment_intents", bytes.NewReader(body))
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", key)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+
+	var respBody createPaymentIntentResponse
+	if err = json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return respBody.toModel(order), nil
+}
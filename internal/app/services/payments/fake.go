@@ -0,0 +1,69 @@
+package payments
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Fake - in-memory payments service for local runs and tests
+type Fake struct {
+	confirmationURL string
+
+	mu      sync.Mutex
+	intents map[models.PaymentID]models.PaymentIntent
+	keys    map[string]models.PaymentID
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.Payments = (*Fake)(nil)
+
+// NewFake - returns fake, confirmationURL is prefix of payment page links
+func NewFake(confirmationURL string) *Fake {
+	return &Fake{
+		confirmationURL: confirmationURL,
+		intents:         make(map[models.PaymentID]models.PaymentIntent),
+		keys:            make(map[string]models.PaymentID),
+	}
+}
+
+func (f *Fake) CreatePaymentIntent(_ context.Context, key string, order *models.Order, expiresAt time.Time) (*models.PaymentIntent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if id, ok := f.keys[key]; ok {
+		intent := f.intents[id]
+		return &intent, nil
+	}
+
+	id := models.PaymentID(uuid.NewString())
+
+	intent := models.PaymentIntent{
+		ID:              id,
+		OrderID:         order.ID,
+		Amount:          order.Total,
+		ConfirmationURL: f.confirmationURL + string(id),
+		ExpiresAt:       expiresAt,
+	}
+
+	f.intents[id] = intent
+	f.keys[key] = id
+
+	return &intent, nil
+}
+
+// Intents - returns created payment intents
+func (f *Fake) Intents() []models.PaymentIntent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]models.PaymentIntent, 0, len(f.intents))
+	for _, intent := range f.intents {
+		res = append(res, intent)
+	}
+	return res
+}
@@ -0,0 +1,49 @@
+package payments
+
+import (
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+type money struct {
+	CurrencyCode string `json:"currency_code"`
+	Units        int64  `json:"units,string"`
+	Nanos        int32  `json:"nanos"`
+}
+
+type createPaymentIntentRequest struct {
+	OrderID   string    `json:"order_id"`
+	UserID    uint64    `json:"user_id"`
+	Amount    money     `json:"amount"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type createPaymentIntentResponse struct {
+	ID              string    `json:"id"`
+	ConfirmationURL string    `json:"confirmation_url"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+func newCreatePaymentIntentRequest(order *models.Order, expiresAt time.Time) createPaymentIntentRequest {
+	return createPaymentIntentRequest{
+		OrderID: order.ID.String(),
+		UserID:  uint64(order.UserID),
+		Amount: money{
+			CurrencyCode: order.Total.CurrencyCode,
+			Units:        order.Total.Units,
+			Nanos:        order.Total.Nanos,
+		},
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (r *createPaymentIntentResponse) toModel(order *models.Order) *models.PaymentIntent {
+	return &models.PaymentIntent{
+		ID:              models.PaymentID(r.ID),
+		OrderID:         order.ID,
+		Amount:          order.Total,
+		ConfirmationURL: r.ConfirmationURL,
+		ExpiresAt:       r.ExpiresAt,
+	}
+}
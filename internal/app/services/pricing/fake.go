@@ -0,0 +1,35 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Fake - pricing for local runs and tests: unit prices are catalog prices of SKUs,
+// delivery cost is a flat tariff of delivery variant
+type Fake struct {
+	deliveryCosts map[models.DeliveryVariantID]models.Money
+}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.Pricing = (*Fake)(nil)
+
+func NewFake(deliveryCosts map[models.DeliveryVariantID]models.Money) *Fake {
+	return &Fake{
+		deliveryCosts: deliveryCosts,
+	}
+}
+
+func (f *Fake) QuotePrices(_ context.Context, order *models.Order) (*models.PriceQuote, error) {
+	unitPrices := make(map[models.SKUID]models.Money, len(order.Items))
+	for _, item := range order.Items {
+		unitPrices[item.SKU.ID] = item.SKU.Price
+	}
+
+	return &models.PriceQuote{
+		UnitPrices:   unitPrices,
+		DeliveryCost: f.deliveryCosts[order.DeliveryVariantID],
+	}, nil
+}
@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+type money struct {
+	CurrencyCode string `json:"currency_code"`
+	Units        int64  `json:"units,string"`
+	Nanos        int32  `json:"nanos"`
+}
+
+func (m money) toModel() models.Money {
+	return models.Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        m.Units,
+		Nanos:        m.Nanos,
+	}
+}
+
+type quoteItem struct {
+	SKUID       uint64 `json:"sku_id"`
+	Quantity    uint32 `json:"quantity"`
+	WarehouseID uint64 `json:"warehouse_id"`
+}
+
+type quotePricesRequest struct {
+	UserID            uint64      `json:"user_id"`
+	Items             []quoteItem `json:"items"`
+	DeliveryVariantID uint64      `json:"delivery_variant_id"`
+}
+
+type unitPrice struct {
+	SKUID uint64 `json:"sku_id"`
+	Price money  `json:"price"`
+}
+
+type quotePricesResponse struct {
+	UnitPrices   []unitPrice `json:"unit_prices"`
+	DeliveryCost money       `json:"delivery_cost"`
+}
+
+func newQuotePricesRequest(order *models.Order) quotePricesRequest {
+	items := make([]quoteItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, quoteItem{
+			SKUID:       uint64(item.SKU.ID),
+			Quantity:    item.Quantity,
+			WarehouseID: uint64(item.WarehouseID),
+		})
+	}
+
+	return quotePricesRequest{
+		UserID:            uint64(order.UserID),
+		Items:             items,
+		DeliveryVariantID: uint64(order.DeliveryVariantID),
+	}
+}
+
+func (r *quotePricesResponse) toModel() *models.PriceQuote {
+	unitPrices := make(map[models.SKUID]models.Money, len(r.UnitPrices))
+	for _, p := range r.UnitPrices {
+		unitPrices[models.SKUID(p.SKUID)] = p.Price.toModel()
+	}
+
+	return &models.PriceQuote{
+		UnitPrices:   unitPrices,
+		DeliveryCost: r.DeliveryCost.toModel(),
+	}
+}
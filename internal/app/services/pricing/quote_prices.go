@@ -0,0 +1,56 @@
+package pricing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (c *Client) QuotePrices(ctx context.Context, order *models.Order) (*models.PriceQuote, error) {
+	const api = "pricing.QuotePrices"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("user_id", int64(order.UserID)),
+		),
+	)
+	defer span.End()
+
+	body, err := json.Marshal(newQuotePricesRequest(order))
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		c.baseURL+"/api/v1/prices:quote", bytes.NewReader(body))
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %d", api, resp.StatusCode)
+	}
+
+	var respBody quotePricesResponse
+	if err = json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return respBody.toModel(), nil
+}
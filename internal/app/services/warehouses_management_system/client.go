@@ -1,19 +1,29 @@
 package warehouses_management_system
 
-import "github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+import (
+	"net/http"
+	"strings"
 
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+)
+
+// Client - HTTP client of the warehouses management system
 type Client struct {
-	/*
-		HTTP, gRPC, ... client
-	*/
+	baseURL    string
+	httpClient *http.Client
 }
 
 // Check that we implemet contract for usecase
 var _ orders_management_system.WarehouseManagementSystem = (*Client)(nil)
 
 // NewClient - returns WMS service adapter
-func NewClient( /* ... */ ) *Client {
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
 	return &Client{
-		/* ... */
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
 	}
 }
@@ -0,0 +1,29 @@
+package warehouses_management_system
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+// Fake - WMS with unlimited stocks for local runs
+type Fake struct{}
+
+// Check that we implemet contract for usecase
+var _ orders_management_system.WarehouseManagementSystem = Fake{}
+
+func NewFake() Fake {
+	return Fake{}
+}
+
+func (Fake) ReserveStocks(ctx context.Context, userID models.UserID, items []models.Item) error {
+	logger.InfoKV(ctx, "stock reserved", "user_id", userID, "items", len(items))
+	return nil
+}
+
+func (Fake) ReleaseStocks(ctx context.Context, key string, userID models.UserID, items []models.Item) error {
+	logger.InfoKV(ctx, "stock released", "key", key, "user_id", userID, "items", len(items))
+	return nil
+}
@@ -0,0 +1,31 @@
+package warehouses_management_system
+
+import (
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+type stocksRequest struct {
+	UserID uint64      `json:"user_id"`
+	Items  []stockItem `json:"items"`
+}
+
+type stockItem struct {
+	SKUID       uint64 `json:"sku_id"`
+	WarehouseID uint64 `json:"warehouse_id"`
+	Quantity    uint32 `json:"quantity"`
+}
+
+func newStocksRequest(userID models.UserID, items []models.Item) stocksRequest {
+	req := stocksRequest{
+		UserID: uint64(userID),
+		Items:  make([]stockItem, 0, len(items)),
+	}
+	for _, item := range items {
+		req.Items = append(req.Items, stockItem{
+			SKUID:       uint64(item.SKU.ID),
+			WarehouseID: uint64(item.WarehouseID),
+			Quantity:    item.Quantity,
+		})
+	}
+	return req
+}
@@ -0,0 +1,44 @@
+package warehouses_management_system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (c *Client) ReleaseStocks(
+	ctx context.Context,
+	key string,
+	userID models.UserID,
+	items []models.Item,
+) error {
+	const api = "warehouses_management_system.ReleaseStocks"
+
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("user_id", int64(userID)),
+		),
+	)
+	defer span.End()
+
+	status, err := c.post(ctx, "/api/v1/stocks:release", key, newStocksRequest(userID, items))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return pkgerrors.Wrap(api, err)
+	}
+
+	if status != http.StatusOK {
+		err = fmt.Errorf("%s: unexpected status %d", api, status)
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
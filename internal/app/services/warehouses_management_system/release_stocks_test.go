@@ -0,0 +1,51 @@
+//go:build test
+
+package warehouses_management_system
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReleaseStocks(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "Test 1. Positive. Stocks are released.",
+			status: http.StatusOK,
+		},
+		{
+			name:    "Test 2. Negative. Unexpected status.",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var idempotencyKey string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/stocks:release", r.URL.Path)
+				idempotencyKey = r.Header.Get("Idempotency-Key")
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, srv.Client()).ReleaseStocks(context.Background(), "order/cancel", 1, []models.Item{{Quantity: 1}})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, "order/cancel", idempotencyKey)
+		})
+	}
+}
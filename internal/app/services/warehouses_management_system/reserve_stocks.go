@@ -2,28 +2,46 @@ package warehouses_management_system
 
 import (
 	"context"
-	"time"
+	"fmt"
+	"net/http"
 
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
-	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
-	"github.com/opentracing/opentracing-go"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func (r *Client) ReserveStocks(
+func (c *Client) ReserveStocks(
 	ctx context.Context,
 	userID models.UserID,
 	items []models.Item,
 ) error {
 	const api = "warehouses_management_system.ReserveStocks"
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "warehouses_management_system.ReserveStocks")
-	defer span.Finish()
-
-	span.SetTag("user_id", userID)
-
-	logger.Info(ctx, "stock reserved")
-
-	time.Sleep(50 * time.Millisecond)
-
-	return nil
+	ctx, span := tracing.Start(ctx, api,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int64("user_id", int64(userID)),
+		),
+	)
+	defer span.End()
+
+	status, err := c.post(ctx, "/api/v1/stocks:reserve", "", newStocksRequest(userID, items))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return pkgerrors.Wrap(api, err)
+	}
+
+	switch status {
+	case http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		return pkgerrors.Wrap(api, orders_management_system.ErrReserveStocks)
+	default:
+		err = fmt.Errorf("%s: unexpected status %d", api, status)
+		tracing.RecordError(span, err)
+		return err
+	}
 }
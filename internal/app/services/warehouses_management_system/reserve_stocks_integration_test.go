@@ -5,6 +5,7 @@ package warehouses_management_system
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,7 +14,7 @@ import (
 func TestClient_ReserveStocks(t *testing.T) {
 	// prepare
 
-	r := &Client{}
+	r := NewClient(os.Getenv("WMS_URL"), nil)
 
 	t.Run("Test 1.", func(t *testing.T) {
 		if err := r.ReserveStocks(context.Background(), 1, nil); err == nil {
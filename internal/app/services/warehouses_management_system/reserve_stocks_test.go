@@ -0,0 +1,66 @@
+//go:build test
+
+package warehouses_management_system
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestClient_ReserveStocks_Propagation(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{
+			name:   "Test 1. Positive. Stocks are reserved.",
+			status: http.StatusOK,
+		},
+		{
+			name:    "Test 2. Negative. Not enough stocks.",
+			status:  http.StatusConflict,
+			wantErr: orders_management_system.ErrReserveStocks,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var traceParent string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/stocks:reserve", r.URL.Path)
+				traceParent = r.Header.Get("traceparent")
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+			err := NewClient(srv.URL, srv.Client()).ReserveStocks(ctx, 1, []models.Item{{Quantity: 1}})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			// without SDK span of client is non-recording and carries parent context
+			assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceParent)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+const defaultExpiredOrdersBatchSize = 100
+
+// CancelExpiredOrders - cancels orders which were not paid in time and releases their stocks and delivery slots,
+// returns number of cancelled orders
+func (oms *usecase) CancelExpiredOrders(ctx context.Context) (int, error) {
+	const api = "orders_management_system.usecase.CancelExpiredOrders"
+
+	batchSize := oms.cfg.ExpiredOrdersBatchSize
+	if batchSize == 0 {
+		batchSize = defaultExpiredOrdersBatchSize
+	}
+
+	ids, err := oms.OrdersStorage.ListExpiredOrders(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, pkgerrors.Wrap(api, err)
+	}
+
+	var (
+		cancelled int
+		errs      []error
+	)
+	for _, id := range ids {
+		ok, err := oms.cancelExpiredOrder(ctx, id)
+		if ok {
+			cancelled++
+		}
+		if err != nil {
+			errs = append(errs, pkgerrors.Wrap(id.String(), err))
+		}
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return cancelled, pkgerrors.Wrap(api, err)
+	}
+
+	return cancelled, nil
+}
+
+// cancelExpiredOrder - cancels single order if it is still awaiting payment after deadline,
+// reservations of the order are released after commit, so order is cancelled even when release fails
+func (oms *usecase) cancelExpiredOrder(ctx context.Context, id models.OrderID) (bool, error) {
+	var release *models.ReservationRelease
+	err := oms.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			release = nil
+
+			order, err := oms.OrdersStorage.GetOrderForUpdate(txCtx, id)
+			if err != nil {
+				return err
+			}
+
+			// order might be paid or cancelled concurrently
+			if order.Status != models.OrderStatusAwaitingPayment || time.Now().Before(order.PaymentExpiresAt) {
+				return nil
+			}
+
+			before := *order
+			if err = oms.changeOrder(txCtx, order, models.OrderCancelledData{Reason: models.CancelReasonPaymentExpired}); err != nil {
+				return err
+			}
+			if err = oms.AuditLog.Record(txCtx, models.AuditActionCancelExpiredOrder, &before, order); err != nil {
+				return err
+			}
+
+			release = &models.ReservationRelease{
+				Key:               reservationKey(order.ID, "cancel"),
+				OrderID:           order.ID,
+				UserID:            order.UserID,
+				Items:             order.Items,
+				DeliveryVariantID: order.DeliveryVariantID,
+				DeliverySlotID:    order.DeliverySlotID,
+			}
+			return nil
+		},
+	)
+	if err != nil || release == nil {
+		return false, err
+	}
+
+	return true, oms.releaseReservations(ctx, *release)
+}
@@ -0,0 +1,194 @@
+//go:build test
+
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_usecase_CancelExpiredOrders(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		expiredID = models.OrderID(uuid.New())
+		paidID    = models.OrderID(uuid.New())
+
+		items = []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 3, WarehouseID: 4}}
+
+		expired = &models.Order{
+			ID:     expiredID,
+			UserID: 1,
+			Status: models.OrderStatusAwaitingPayment,
+			Items:  items,
+			DeliveryOrderInfo: models.DeliveryOrderInfo{
+				DeliveryVariantID: 5,
+				DeliverySlotID:    7,
+			},
+			PaymentOrderInfo: models.PaymentOrderInfo{
+				PaymentExpiresAt: time.Now().Add(-time.Minute),
+			},
+		}
+		paid = &models.Order{
+			ID:     paidID,
+			UserID: 1,
+			Status: models.OrderStatusPaid, // paid concurrently
+			Items:  items,
+		}
+	)
+
+	type fields struct {
+		WarehouseManagementSystem *mocks.WarehouseManagementSystem
+		DeliveryService           *mocks.DeliveryService
+		OrdersStorage             *mocks.OrdersStorage
+		TransactionManager        *mocks.TransactionManager
+		OrderEventBus             *mocks.OrderEventBus
+		AuditLog                  *mocks.AuditLog
+
+		// inTx - reports whether transaction is running
+		inTx bool
+		// releasedInTx - reports whether reservations were released within transaction
+		releasedInTx bool
+	}
+
+	tests := []struct {
+		name    string
+		want    int
+		wantErr bool
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Paid order is skipped.",
+			want: 1,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListExpiredOrders", ctx, mock.Anything, uint64(defaultExpiredOrdersBatchSize)).
+					Return([]models.OrderID{expiredID, paidID}, nil)
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, expiredID).
+					Return(expired, nil)
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, paidID).
+					Return(paid, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.MatchedBy(func(order *models.Order) bool {
+					return order.ID == expiredID && order.Status == models.OrderStatusCancelled
+				}), orderEventOfType(models.OrderEventCancelled)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCancelled)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCancelled && event.Status == models.OrderStatusCancelled
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCancelExpiredOrder, mock.MatchedBy(func(before *models.Order) bool {
+					return before.Status == models.OrderStatusAwaitingPayment
+				}), mock.MatchedBy(func(after *models.Order) bool {
+					return after.Status == models.OrderStatusCancelled
+				})).
+					Return(nil)
+				f.WarehouseManagementSystem.On("ReleaseStocks", ctx, expiredID.String()+"/cancel", models.UserID(1), items).
+					Return(nil).
+					Run(func(mock.Arguments) { f.releasedInTx = f.releasedInTx || f.inTx })
+				f.DeliveryService.On("ReleaseDeliverySlot", ctx, expiredID.String()+"/cancel", models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil).
+					Run(func(mock.Arguments) { f.releasedInTx = f.releasedInTx || f.inTx })
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 1)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 1)
+				assert.False(t, f.releasedInTx, "reservations must be released after commit")
+			},
+		},
+		{
+			name:    "Test 2. Negative. ReleaseStocks returns error, order is cancelled anyway.",
+			want:    1,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListExpiredOrders", ctx, mock.Anything, uint64(defaultExpiredOrdersBatchSize)).
+					Return([]models.OrderID{expiredID}, nil)
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, expiredID).
+					Return(&models.Order{
+						ID:               expiredID,
+						UserID:           1,
+						Status:           models.OrderStatusAwaitingPayment,
+						Items:            items,
+						PaymentOrderInfo: expired.PaymentOrderInfo,
+					}, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, orderEventOfType(models.OrderEventCancelled)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCancelled)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCancelled && event.Status == models.OrderStatusCancelled
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCancelExpiredOrder, mock.MatchedBy(func(before *models.Order) bool {
+					return before.Status == models.OrderStatusAwaitingPayment
+				}), mock.MatchedBy(func(after *models.Order) bool {
+					return after.Status == models.OrderStatusCancelled
+				})).
+					Return(nil)
+				f.WarehouseManagementSystem.On("ReleaseStocks", ctx, expiredID.String()+"/cancel", models.UserID(1), items).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fields{
+				WarehouseManagementSystem: mocks.NewWarehouseManagementSystem(t),
+				DeliveryService:           mocks.NewDeliveryService(t),
+				OrdersStorage:             mocks.NewOrdersStorage(t),
+				TransactionManager:        mocks.NewTransactionManager(t),
+				OrderEventBus:             mocks.NewOrderEventBus(t),
+				AuditLog:                  mocks.NewAuditLog(t),
+			}
+			f.TransactionManager.On("RunReadCommitted", mock.Anything, mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, _ pgx.TxAccessMode, fn func(context.Context) error) error {
+					f.inTx = true
+					defer func() { f.inTx = false }()
+					return fn(ctx)
+				})
+			oms := &usecase{
+				Deps: Deps{
+					WarehouseManagementSystem: f.WarehouseManagementSystem,
+					DeliveryService:           f.DeliveryService,
+					OrdersStorage:             f.OrdersStorage,
+					TransactionManager:        f.TransactionManager,
+					OrderEventBus:             f.OrderEventBus,
+					AuditLog:                  f.AuditLog,
+				},
+			}
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			got, err := oms.CancelExpiredOrders(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CancelExpiredOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
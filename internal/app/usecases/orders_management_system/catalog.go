@@ -0,0 +1,57 @@
+package orders_management_system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// enrichItems - fills SKU names and price snapshots of order items from catalog,
+// unknown and delisted SKUs are rejected
+func (oms *usecase) enrichItems(ctx context.Context, items []models.Item) error {
+	ids := make([]models.SKUID, 0, len(items))
+	seen := make(map[models.SKUID]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.SKU.ID]; ok {
+			continue
+		}
+		seen[item.SKU.ID] = struct{}{}
+		ids = append(ids, item.SKU.ID)
+	}
+
+	skus, err := oms.Catalog.GetSKUs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	catalog := make(map[models.SKUID]models.CatalogSKU, len(skus))
+	for _, sku := range skus {
+		catalog[sku.ID] = sku
+	}
+
+	var violations []models.FieldViolation
+	for i := range items {
+		sku, ok := catalog[items[i].SKU.ID]
+		switch {
+		case !ok:
+			violations = append(violations, models.FieldViolation{
+				Field:       fmt.Sprintf("items[%d].id", i),
+				Description: fmt.Sprintf("SKU %d does not exist", items[i].SKU.ID),
+			})
+		case !sku.Available:
+			violations = append(violations, models.FieldViolation{
+				Field:       fmt.Sprintf("items[%d].id", i),
+				Description: fmt.Sprintf("SKU %d is delisted", items[i].SKU.ID),
+			})
+		default:
+			items[i].SKU = sku.SKU
+		}
+	}
+
+	if len(violations) > 0 {
+		return &models.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package orders_management_system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+// ConfirmPayment - moves order awaiting payment to paid, repeated confirmation of paid order is no-op.
+// Order is changed only when it has expectedVersion, 0 - any version
+func (oms *usecase) ConfirmPayment(ctx context.Context, orderID models.OrderID, paymentID models.PaymentID, expectedVersion int64) (*models.Order, error) {
+	const api = "orders_management_system.usecase.ConfirmPayment"
+
+	var order *models.Order
+	err := oms.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			var err error
+			if order, err = oms.OrdersStorage.GetOrderForUpdate(txCtx, orderID); err != nil {
+				return err
+			}
+
+			if order.PaymentID != paymentID {
+				return models.ErrPaymentMismatch
+			}
+
+			switch order.Status {
+			case models.OrderStatusAwaitingPayment:
+			case models.OrderStatusPaid:
+				return nil // already confirmed
+			default:
+				return fmt.Errorf("order is %s: %w", order.Status, models.ErrInvalidOrderStatus)
+			}
+
+			if expectedVersion != 0 && order.Version != expectedVersion {
+				return fmt.Errorf("order version is %d, expected %d: %w", order.Version, expectedVersion, models.ErrVersionConflict)
+			}
+
+			before := *order
+			if err = oms.changeOrder(txCtx, order, models.OrderPaidData{PaymentID: paymentID}); err != nil {
+				return err
+			}
+			if err = oms.AuditLog.Record(txCtx, models.AuditActionConfirmPayment, &before, order); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return order, nil
+}
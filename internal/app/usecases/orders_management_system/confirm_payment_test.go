@@ -0,0 +1,167 @@
+//go:build test
+
+package orders_management_system
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_usecase_ConfirmPayment(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		orderID = models.OrderID(uuid.New())
+	)
+
+	newOrder := func(status models.OrderStatus) *models.Order {
+		return &models.Order{
+			ID:      orderID,
+			Version: 2,
+			UserID:  1,
+			Status:  status,
+			PaymentOrderInfo: models.PaymentOrderInfo{
+				PaymentID: "pi_1",
+			},
+		}
+	}
+
+	type fields struct {
+		OrdersStorage      *mocks.OrdersStorage
+		TransactionManager *mocks.TransactionManager
+		OrderEventBus      *mocks.OrderEventBus
+		AuditLog           *mocks.AuditLog
+	}
+
+	tests := []struct {
+		name            string
+		paymentID       models.PaymentID
+		expectedVersion int64
+		wantStatus      models.OrderStatus
+		wantVersion     int64
+		wantErr         error
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name:            "Test 1. Positive.",
+			paymentID:       "pi_1",
+			expectedVersion: 2,
+			wantStatus:      models.OrderStatusPaid,
+			wantVersion:     3,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.MatchedBy(func(order *models.Order) bool {
+					return order.Status == models.OrderStatusPaid && order.Version == 3
+				}), orderEventOfType(models.OrderEventPaid)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventPaid)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventPaid && event.Status == models.OrderStatusPaid
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionConfirmPayment, mock.MatchedBy(func(before *models.Order) bool {
+					return before.Status == models.OrderStatusAwaitingPayment
+				}), mock.MatchedBy(func(after *models.Order) bool {
+					return after.Status == models.OrderStatusPaid
+				})).
+					Return(nil)
+			},
+		},
+		{
+			name:            "Test 2. Positive. Order is already paid.",
+			paymentID:       "pi_1",
+			expectedVersion: 1,
+			wantStatus:      models.OrderStatusPaid,
+			wantVersion:     2,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(newOrder(models.OrderStatusPaid), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OrdersStorage.AssertNumberOfCalls(t, "CreateOutboxMessage", 0)
+			},
+		},
+		{
+			name:      "Test 3. Negative. Payment does not match order.",
+			paymentID: "pi_2",
+			wantErr:   models.ErrPaymentMismatch,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+			},
+		},
+		{
+			name:      "Test 4. Negative. Order is cancelled.",
+			paymentID: "pi_1",
+			wantErr:   models.ErrInvalidOrderStatus,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(newOrder(models.OrderStatusCancelled), nil)
+			},
+		},
+		{
+			name:            "Test 5. Negative. Version conflict.",
+			paymentID:       "pi_1",
+			expectedVersion: 1,
+			wantErr:         models.ErrVersionConflict,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fields{
+				OrdersStorage:      mocks.NewOrdersStorage(t),
+				TransactionManager: mocks.NewTransactionManager(t),
+				OrderEventBus:      mocks.NewOrderEventBus(t),
+				AuditLog:           mocks.NewAuditLog(t),
+			}
+			f.TransactionManager.On("RunReadCommitted", mock.Anything, mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, _ pgx.TxAccessMode, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			oms := &usecase{
+				Deps: Deps{
+					OrdersStorage:      f.OrdersStorage,
+					TransactionManager: f.TransactionManager,
+					OrderEventBus:      f.OrderEventBus,
+					AuditLog:           f.AuditLog,
+				},
+			}
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			got, err := oms.ConfirmPayment(ctx, orderID, tt.paymentID, tt.expectedVersion)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantVersion, got.Version)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package orders_management_system
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
@@ -13,45 +14,124 @@ import (
 func (oms *usecase) CreateOrder(ctx context.Context, userID models.UserID, info CreateOrderInfo) (*models.Order, error) {
 	const api = "orders_management_system.usecase.CreateOrder"
 
-	if err := oms.WarehouseManagementSystem.ReserveStocks(ctx, userID, info.Items); err != nil {
-		return nil, pkgerrors.Wrap(api, err)
-	}
-
 	var (
 		orderID = models.OrderID(uuid.New())
 		order   = &models.Order{
 			ID:                orderID,
 			UserID:            userID,
-			Items:             info.Items,
+			Status:            models.OrderStatusAwaitingPayment,
+			Items:             append([]models.Item(nil), info.Items...),
 			DeliveryOrderInfo: info.DeliveryOrderInfo,
 		}
 	)
 
+	if err := oms.enrichItems(ctx, order.Items); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	if err := oms.BusinessRules.ValidateOrder(ctx, order); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	slot, err := oms.findDeliverySlot(ctx, order)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	if err = oms.priceOrder(ctx, order); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	// invalid promo code is rejected before reservations are made,
+	// it is redeemed under lock of redemptions of the user within transaction
+	if info.PromoCode != "" {
+		if err = oms.applyPromoCode(ctx, order, info.PromoCode); err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+	}
+
+	// reservations are returned when the order is not created
+	release := models.ReservationRelease{
+		Key:     reservationKey(orderID, "create"),
+		OrderID: orderID,
+		UserID:  userID,
+	}
+
+	if err = oms.WarehouseManagementSystem.ReserveStocks(ctx, userID, order.Items); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	release.Items = order.Items
+
+	if err = oms.DeliveryService.ReserveDeliverySlot(ctx, userID, order.DeliveryVariantID, slot.ID); err != nil {
+		return nil, pkgerrors.Wrap(api, oms.compensate(ctx, err, release))
+	}
+	order.DeliverySlotID = slot.ID
+	release.DeliveryVariantID, release.DeliverySlotID = order.DeliveryVariantID, slot.ID
+
+	// payment intent is not created within transaction as it is retried, intent of not created order expires unpaid
+	if err = oms.createPaymentIntent(ctx, order); err != nil {
+		return nil, pkgerrors.Wrap(api, oms.compensate(ctx, err, release))
+	}
+	total := order.Total
+
+	// every attempt starts from the draft as changes of failed attempt are rolled back with its transaction
+	draft := *order
+
 	const retries = 3
-	var err error
 	for i := 1; i <= retries; i++ {
-		err := oms.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		err = oms.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
 			func(txCtx context.Context) error {
-				if err = oms.OrdersStorage.CreateOrder(txCtx, order); err != nil {
+				*order = draft
+
+				var redemption *models.PromoRedemption
+				if info.PromoCode != "" {
+					var err error
+					if redemption, err = oms.redeemPromoCode(txCtx, order, info.PromoCode); err != nil {
+						return err
+					}
+					if order.Total != total {
+						return &models.ValidationError{
+							Violations: []models.FieldViolation{{
+								Field:       "promo_code",
+								Description: fmt.Sprintf("promo code %q is changed, try again", info.PromoCode),
+							}},
+						}
+					}
+				}
+
+				if err := oms.changeOrder(txCtx, order, models.OrderCreatedData{Order: *order}); err != nil {
 					return err
 				}
-				if err = oms.OrdersStorage.CreateOutboxMessage(txCtx, order); err != nil {
+				if err := oms.AuditLog.Record(txCtx, models.AuditActionCreateOrder, nil, order); err != nil {
 					return err
 				}
+				if redemption != nil {
+					if err := oms.PromotionsStorage.CreateRedemption(txCtx, redemption); err != nil {
+						return err
+					}
+				}
 
 				return nil
 			},
 		)
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			break // promo code rejected, retry won't help
+		}
 		if err != nil {
 			if errors.Is(err, models.ErrAlreadyExists) {
-				order.ID = models.OrderID(uuid.New())
+				draft.ID = models.OrderID(uuid.New())
+				// payment intent is bound to order ID
+				if err = oms.createPaymentIntent(ctx, &draft); err != nil {
+					break
+				}
 			}
 			continue
 		}
 		break
 	}
 	if err != nil {
-		return nil, pkgerrors.Wrap(api, err)
+		return nil, pkgerrors.Wrap(api, oms.compensate(ctx, err, release))
 	}
 
 	return order, nil
@@ -6,23 +6,113 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_usecase_CreateOrder(t *testing.T) {
 	var (
 		ctx  = context.Background()
 		date = time.Now()
+
+		catalogSKUs = []models.CatalogSKU{
+			{SKU: models.SKU{ID: 2, Name: "Item 2"}, Available: true},
+		}
+
+		priceQuote = &models.PriceQuote{
+			UnitPrices: map[models.SKUID]models.Money{
+				2: {CurrencyCode: "RUB", Units: 10, Nanos: 500_000_000},
+			},
+			DeliveryCost: models.Money{CurrencyCode: "RUB", Units: 300},
+		}
+
+		pricedItems = []models.Item{
+			{
+				SKU:         models.SKU{ID: 2, Name: "Item 2"},
+				Quantity:    3,
+				WarehouseID: 4,
+				UnitPrice:   models.Money{CurrencyCode: "RUB", Units: 10, Nanos: 500_000_000},
+				Total:       models.Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+			},
+		}
+
+		orderPricing = models.OrderPricing{
+			CurrencyCode: "RUB",
+			Subtotal:     models.Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+			DeliveryCost: models.Money{CurrencyCode: "RUB", Units: 300},
+			Discount:     models.Money{CurrencyCode: "RUB"},
+			Total:        models.Money{CurrencyCode: "RUB", Units: 331, Nanos: 500_000_000},
+		}
+
+		promoCampaign = &models.PromoCampaign{
+			ID:                9,
+			Code:              "SALE10",
+			DiscountType:      models.DiscountTypePercentage,
+			PercentOff:        1000, // 10%
+			ValidFrom:         date.Add(-time.Hour),
+			ValidTo:           date.Add(time.Hour),
+			UsageLimitPerUser: 1,
+		}
+
+		discountedOrderPricing = models.OrderPricing{
+			CurrencyCode: "RUB",
+			Subtotal:     models.Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+			DeliveryCost: models.Money{CurrencyCode: "RUB", Units: 300},
+			Discount:     models.Money{CurrencyCode: "RUB", Units: 3, Nanos: 150_000_000},
+			PromoCode:    "SALE10",
+			Total:        models.Money{CurrencyCode: "RUB", Units: 328, Nanos: 350_000_000},
+		}
+
+		paymentIntent = &models.PaymentIntent{
+			ID:              "pi_1",
+			ConfirmationURL: "https://pay.example.com/pi_1",
+			ExpiresAt:       date.Add(15 * time.Minute),
+		}
+
+		paymentOrderInfo = models.PaymentOrderInfo{
+			PaymentID:        "pi_1",
+			PaymentURL:       "https://pay.example.com/pi_1",
+			PaymentExpiresAt: date.Add(15 * time.Minute),
+		}
+
+		// createKey - idempotency key of release of reservations made for order which is not created
+		createKey = mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, "/create") })
+		// appended - events appended to order streams by the test case
+		appended []models.OrderDomainEvent
+
+		// orderIDKey - idempotency key of payment intent of created order
+		orderIDKey = mock.MatchedBy(func(key string) bool { _, err := uuid.Parse(key); return err == nil })
+
+		deliveryVariant = &models.DeliveryVariant{
+			ID:           5,
+			WarehouseIDs: []models.WarehouseID{4},
+			Slots: []models.DeliverySlot{
+				{ID: 6, From: date.Add(-time.Hour), To: date.Add(-time.Minute), Available: true},
+				{ID: 7, From: date.Add(-time.Hour), To: date.Add(time.Hour), Available: true},
+			},
+		}
 	)
 	type fields struct {
 		WarehouseManagementSystem *mocks.WarehouseManagementSystem
 		OrdersStorage             *mocks.OrdersStorage
+		Catalog                   *mocks.Catalog
+		DeliveryService           *mocks.DeliveryService
+		Pricing                   *mocks.Pricing
+		Payments                  *mocks.Payments
+		PromotionsStorage         *mocks.PromotionsStorage
+		BusinessRules             *mocks.BusinessRules
+		TransactionManager        *mocks.TransactionManager
+		OrderEventBus             *mocks.OrderEventBus
+		AuditLog                  *mocks.AuditLog
 	}
 
 	type args struct {
@@ -59,53 +149,64 @@ func Test_usecase_CreateOrder(t *testing.T) {
 				},
 			},
 			want: &models.Order{
-				UserID: 1,
-				Items: []models.Item{
-					{
-						SKU:         models.SKU{ID: 2, Name: "Item 2"},
-						Quantity:    3,
-						WarehouseID: 4,
-					},
-				},
+				Version: 1,
+				UserID:  1,
+				Status:  models.OrderStatusAwaitingPayment,
+				Items:   pricedItems,
 				DeliveryOrderInfo: models.DeliveryOrderInfo{
 					DeliveryVariantID: 5,
 					DeliveryDate:      date,
+					DeliverySlotID:    7,
 				},
+				OrderPricing:     orderPricing,
+				PaymentOrderInfo: paymentOrderInfo,
 			},
 			wantErr: false,
 
 			on: func(f *fields) {
-				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
-					{
-						SKU:         models.SKU{ID: 2, Name: "Item 2"},
-						Quantity:    3,
-						WarehouseID: 4,
-					},
-				}).
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
 					Return(nil)
-				f.OrdersStorage.On("CreateOrder", ctx, mock.MatchedBy(func(order *models.Order) bool {
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.Anything, mock.Anything).
+					Return(paymentIntent, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.MatchedBy(func(order *models.Order) bool {
 					return order != nil &&
 						order.UserID == 1 &&
-						reflect.DeepEqual(order.Items, []models.Item{
-							{
-								SKU:         models.SKU{ID: 2, Name: "Item 2"},
-								Quantity:    3,
-								WarehouseID: 4,
-							},
-						}) &&
+						reflect.DeepEqual(order.Items, pricedItems) &&
+						reflect.DeepEqual(order.OrderPricing, orderPricing) &&
 						reflect.DeepEqual(
 							order.DeliveryOrderInfo, models.DeliveryOrderInfo{
 								DeliveryVariantID: 5,
 								DeliveryDate:      date,
+								DeliverySlotID:    7,
 							},
 						) &&
 						order.ID != models.OrderID{} // not empty
-				})).
+				}), orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCreated && event.Status == models.OrderStatusAwaitingPayment
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCreateOrder, (*models.Order)(nil), mock.Anything).
 					Return(nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 1)
-				f.OrdersStorage.AssertNumberOfCalls(t, "CreateOrder", 1)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 1)
+				f.OrdersStorage.AssertNumberOfCalls(t, "CreateOutboxMessage", 1)
 			},
 		},
 		{
@@ -131,22 +232,24 @@ func Test_usecase_CreateOrder(t *testing.T) {
 			wantErr: true,
 
 			on: func(f *fields) {
-				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
-					{
-						SKU:         models.SKU{ID: 2, Name: "Item 2"},
-						Quantity:    3,
-						WarehouseID: 4,
-					},
-				}).
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
 					Return(errors.New("some error"))
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 1)
-				f.OrdersStorage.AssertNumberOfCalls(t, "CreateOrder", 0)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 0)
 			},
 		},
 		{
-			name: "Test 3. Negative. CreateOrder returns error.",
+			name: "Test 3. Negative. AppendOrderEvent returns error.",
 			args: args{
 				ctx:    ctx, // dumm
 				userID: 1,
@@ -168,37 +271,585 @@ func Test_usecase_CreateOrder(t *testing.T) {
 			wantErr: true,
 
 			on: func(f *fields) {
-				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
-					{
-						SKU:         models.SKU{ID: 2, Name: "Item 2"},
-						Quantity:    3,
-						WarehouseID: 4,
-					},
-				}).
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
 					Return(nil)
-				f.OrdersStorage.On("CreateOrder", ctx, mock.MatchedBy(func(order *models.Order) bool {
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.Anything, mock.Anything).
+					Return(paymentIntent, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.MatchedBy(func(order *models.Order) bool {
 					return order != nil &&
 						order.UserID == 1 &&
-						reflect.DeepEqual(order.Items, []models.Item{
-							{
-								SKU:         models.SKU{ID: 2, Name: "Item 2"},
-								Quantity:    3,
-								WarehouseID: 4,
-							},
-						}) &&
+						reflect.DeepEqual(order.Items, pricedItems) &&
+						reflect.DeepEqual(order.OrderPricing, orderPricing) &&
 						reflect.DeepEqual(
 							order.DeliveryOrderInfo, models.DeliveryOrderInfo{
 								DeliveryVariantID: 5,
 								DeliveryDate:      date,
+								DeliverySlotID:    7,
 							},
 						) &&
 						order.ID != models.OrderID{} // not empty
-				})).
-					Return(models.ErrAlreadyExists)
+				}), orderEventOfType(models.OrderEventCreated)).
+					Return(errors.New("some error"))
+				f.WarehouseManagementSystem.On("ReleaseStocks", mock.Anything, createKey, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReleaseDeliverySlot", mock.Anything, createKey, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 1)
-				f.OrdersStorage.AssertNumberOfCalls(t, "CreateOrder", 3)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 3)
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 3)
+				f.Payments.AssertNumberOfCalls(t, "CreatePaymentIntent", 1) // intent is not recreated by retries
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 1)
+			},
+		},
+		{
+			name: "Test 4. Negative. Business rules are violated.",
+			args: args{
+				ctx:    ctx, // dumm
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2, Name: "Item 2"},
+							Quantity:    3000,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(&models.ValidationError{
+						Violations: []models.FieldViolation{
+							{Field: "items.quantity", Description: "too many"},
+						},
+					})
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BusinessRules.AssertNumberOfCalls(t, "ValidateOrder", 1)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 0)
+			},
+		},
+		{
+			name: "Test 5. Negative. Delivery variant does not serve the warehouse.",
+			args: args{
+				ctx:    ctx, // dumm
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2, Name: "Item 2"},
+							Quantity:    3,
+							WarehouseID: 8,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.DeliveryService.AssertNumberOfCalls(t, "GetDeliveryVariant", 1)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReserveDeliverySlot", 0)
+			},
+		},
+		{
+			name: "Test 6. Negative. No available delivery slot.",
+			args: args{
+				ctx:    ctx, // dumm
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2, Name: "Item 2"},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date.Add(2 * time.Hour),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReserveDeliverySlot", 0)
+			},
+		},
+		{
+			name: "Test 7. Negative. SKU is delisted.",
+			args: args{
+				ctx:    ctx, // dumm
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+						{
+							SKU:         models.SKU{ID: 3},
+							Quantity:    1,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2, 3}).
+					Return([]models.CatalogSKU{
+						{SKU: models.SKU{ID: 2, Name: "Item 2"}, Available: false},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.Catalog.AssertNumberOfCalls(t, "GetSKUs", 1)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+			},
+		},
+		{
+			name: "Test 8. Positive. Promo code is applied.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+					PromoCode: "SALE10",
+				},
+			},
+			want: &models.Order{
+				Version: 1,
+				UserID:  1,
+				Status:  models.OrderStatusAwaitingPayment,
+				Items:   pricedItems,
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 5,
+					DeliveryDate:      date,
+					DeliverySlotID:    7,
+				},
+				OrderPricing:     discountedOrderPricing,
+				PaymentOrderInfo: paymentOrderInfo,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+				f.PromotionsStorage.On("GetCampaignByCode", ctx, "SALE10").
+					Return(promoCampaign, nil)
+				// usage limit is guaranteed by lock of redemptions of the user, not of the campaign
+				f.PromotionsStorage.On("LockRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(nil)
+				f.PromotionsStorage.On("CountRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(uint32(0), nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.MatchedBy(func(order *models.Order) bool {
+					return order.Total == discountedOrderPricing.Total // intent is created for discounted total
+				}), mock.Anything).
+					Return(paymentIntent, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.MatchedBy(func(order *models.Order) bool {
+					return order != nil &&
+						reflect.DeepEqual(order.OrderPricing, discountedOrderPricing)
+				}), orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCreated && event.Status == models.OrderStatusAwaitingPayment
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCreateOrder, (*models.Order)(nil), mock.Anything).
+					Return(nil)
+				f.PromotionsStorage.On("CreateRedemption", ctx, mock.MatchedBy(func(r *models.PromoRedemption) bool {
+					return r != nil &&
+						r.CampaignID == 9 &&
+						r.UserID == 1 &&
+						r.Discount == discountedOrderPricing.Discount &&
+						r.OrderID != models.OrderID{} // not empty
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 1)
+				f.PromotionsStorage.AssertNumberOfCalls(t, "CreateRedemption", 1)
+				f.PromotionsStorage.AssertNumberOfCalls(t, "LockRedemptions", 1) // pre-check does not lock
+			},
+		},
+		{
+			name: "Test 9. Negative. Promo code usage limit is exceeded.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+					PromoCode: "SALE10",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.PromotionsStorage.On("GetCampaignByCode", ctx, "SALE10").
+					Return(promoCampaign, nil)
+				f.PromotionsStorage.On("CountRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(uint32(1), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				// promo code is rejected before reservations
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReserveDeliverySlot", 0)
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 0)
+				f.PromotionsStorage.AssertNumberOfCalls(t, "CreateRedemption", 0)
+			},
+		},
+		{
+			name: "Test 10. Negative. Delivery slot is not reserved, stocks are released.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(models.ErrDeliverySlotUnavailable)
+				f.WarehouseManagementSystem.On("ReleaseStocks", mock.Anything, createKey, models.UserID(1), pricedItems).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 0)
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 0)
+			},
+		},
+		{
+			name: "Test 11. Negative. Promo code usage limit is exceeded concurrently, reservations are released.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+					PromoCode: "SALE10",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+
+			on: func(f *fields) {
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+				f.PromotionsStorage.On("GetCampaignByCode", ctx, "SALE10").
+					Return(promoCampaign, nil)
+				f.PromotionsStorage.On("CountRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(uint32(0), nil).
+					Once()
+				f.PromotionsStorage.On("LockRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(nil)
+				f.PromotionsStorage.On("CountRedemptions", ctx, models.PromoCampaignID(9), models.UserID(1)).
+					Return(uint32(1), nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.Anything, mock.Anything).
+					Return(paymentIntent, nil)
+				f.WarehouseManagementSystem.On("ReleaseStocks", mock.Anything, createKey, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReleaseDeliverySlot", mock.Anything, createKey, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 1) // no retries
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 1)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 0)
+				f.PromotionsStorage.AssertNumberOfCalls(t, "CreateRedemption", 0)
+			},
+		},
+		{
+			name: "Test 12. Positive. Order is created by retry with version 1.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2, Name: "Item 2"},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want: &models.Order{
+				Version: 1,
+				UserID:  1,
+				Status:  models.OrderStatusAwaitingPayment,
+				Items:   pricedItems,
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 5,
+					DeliveryDate:      date,
+					DeliverySlotID:    7,
+				},
+				OrderPricing:     orderPricing,
+				PaymentOrderInfo: paymentOrderInfo,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				appended = nil
+
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.Anything, mock.Anything).
+					Return(paymentIntent, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil).
+					Run(func(args mock.Arguments) { appended = append(appended, *args.Get(2).(*models.OrderDomainEvent)) })
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(errors.New("some error")).
+					Once()
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCreated && event.Status == models.OrderStatusAwaitingPayment
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCreateOrder, (*models.Order)(nil), mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 2)
+				// failed attempt is rolled back, retry appends the first event of the order again
+				require.Len(t, appended, 2)
+				for _, event := range appended {
+					assert.Equal(t, int64(1), event.Version)
+					assert.Equal(t, int64(0), event.Data.(models.OrderCreatedData).Order.Version)
+				}
+			},
+		},
+		{
+			name: "Test 13. Positive. Order is created with new ID and payment intent after ID conflict.",
+			args: args{
+				ctx:    ctx,
+				userID: 1,
+				info: CreateOrderInfo{
+					Items: []models.Item{
+						{
+							SKU:         models.SKU{ID: 2, Name: "Item 2"},
+							Quantity:    3,
+							WarehouseID: 4,
+						},
+					},
+					DeliveryOrderInfo: models.DeliveryOrderInfo{
+						DeliveryVariantID: 5,
+						DeliveryDate:      date,
+					},
+				},
+			},
+			want: &models.Order{
+				Version: 1,
+				UserID:  1,
+				Status:  models.OrderStatusAwaitingPayment,
+				Items:   pricedItems,
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 5,
+					DeliveryDate:      date,
+					DeliverySlotID:    7,
+				},
+				OrderPricing:     orderPricing,
+				PaymentOrderInfo: paymentOrderInfo,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				appended = nil
+
+				f.Catalog.On("GetSKUs", ctx, []models.SKUID{2}).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), pricedItems).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderIDKey, mock.Anything, mock.Anything).
+					Return(paymentIntent, nil)
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(models.ErrAlreadyExists).
+					Run(func(args mock.Arguments) { appended = append(appended, *args.Get(2).(*models.OrderDomainEvent)) }).
+					Once()
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil).
+					Run(func(args mock.Arguments) { appended = append(appended, *args.Get(2).(*models.OrderDomainEvent)) })
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, orderEventOfType(models.OrderEventCreated)).
+					Return(nil)
+				f.OrdersStorage.On("CreateStatusHistory", ctx, mock.Anything).
+					Return(nil)
+				f.OrderEventBus.On("Publish", ctx, mock.MatchedBy(func(event models.OrderEvent) bool {
+					return event.Type == models.OrderEventCreated && event.Status == models.OrderStatusAwaitingPayment
+				}))
+				f.AuditLog.On("Record", ctx, models.AuditActionCreateOrder, (*models.Order)(nil), mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				require.Len(t, appended, 2)
+				assert.NotEqual(t, appended[0].OrderID, appended[1].OrderID)
+				assert.Equal(t, int64(1), appended[1].Version)
+				// payment intent is bound to order ID
+				f.Payments.AssertNumberOfCalls(t, "CreatePaymentIntent", 2)
+				f.Payments.AssertCalled(t, "CreatePaymentIntent", ctx, appended[1].OrderID.String(), mock.Anything, mock.Anything)
 			},
 		},
 	}
@@ -207,11 +858,34 @@ func Test_usecase_CreateOrder(t *testing.T) {
 			f := &fields{
 				WarehouseManagementSystem: mocks.NewWarehouseManagementSystem(t),
 				OrdersStorage:             mocks.NewOrdersStorage(t),
+				Catalog:                   mocks.NewCatalog(t),
+				DeliveryService:           mocks.NewDeliveryService(t),
+				Pricing:                   mocks.NewPricing(t),
+				Payments:                  mocks.NewPayments(t),
+				PromotionsStorage:         mocks.NewPromotionsStorage(t),
+				BusinessRules:             mocks.NewBusinessRules(t),
+				TransactionManager:        mocks.NewTransactionManager(t),
+				OrderEventBus:             mocks.NewOrderEventBus(t),
+				AuditLog:                  mocks.NewAuditLog(t),
 			}
+			f.TransactionManager.On("RunReadCommitted", mock.Anything, mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, _ pgx.TxAccessMode, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Maybe()
 			oms := &usecase{
 				Deps: Deps{
 					WarehouseManagementSystem: f.WarehouseManagementSystem,
 					OrdersStorage:             f.OrdersStorage,
+					Catalog:                   f.Catalog,
+					DeliveryService:           f.DeliveryService,
+					Pricing:                   f.Pricing,
+					Payments:                  f.Payments,
+					PromotionsStorage:         f.PromotionsStorage,
+					BusinessRules:             f.BusinessRules,
+					TransactionManager:        f.TransactionManager,
+					OrderEventBus:             f.OrderEventBus,
+					AuditLog:                  f.AuditLog,
 				},
 			}
 			if tt.on != nil {
@@ -235,3 +909,10 @@ func Test_usecase_CreateOrder(t *testing.T) {
 		})
 	}
 }
+
+// orderEventOfType - matches domain event of order stream
+func orderEventOfType(eventType models.OrderEventType) interface{} {
+	return mock.MatchedBy(func(event *models.OrderDomainEvent) bool {
+		return event != nil && event.Type == eventType
+	})
+}
@@ -0,0 +1,52 @@
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// findDeliverySlot - checks that delivery variant exists, serves all warehouses of the order
+// and has an available slot at the requested delivery date
+func (oms *usecase) findDeliverySlot(ctx context.Context, order *models.Order) (models.DeliverySlot, error) {
+	variant, err := oms.DeliveryService.GetDeliveryVariant(ctx, order.DeliveryVariantID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return models.DeliverySlot{}, &models.ValidationError{
+				Violations: []models.FieldViolation{
+					{
+						Field:       "delivery_info.delivery_variant_id",
+						Description: "delivery variant does not exist",
+					},
+				},
+			}
+		}
+		return models.DeliverySlot{}, err
+	}
+
+	var violations []models.FieldViolation
+	for i, item := range order.Items {
+		if !variant.ServesWarehouse(item.WarehouseID) {
+			violations = append(violations, models.FieldViolation{
+				Field:       fmt.Sprintf("items[%d].warehouse_id", i),
+				Description: "delivery variant does not serve the warehouse",
+			})
+		}
+	}
+
+	slot, ok := variant.SlotAt(order.DeliveryDate)
+	if !ok {
+		violations = append(violations, models.FieldViolation{
+			Field:       "delivery_info.delivery_date",
+			Description: "no available delivery slot at the requested date",
+		})
+	}
+
+	if len(violations) > 0 {
+		return models.DeliverySlot{}, &models.ValidationError{Violations: violations}
+	}
+
+	return slot, nil
+}
@@ -5,4 +5,13 @@ import "github.com/moguchev/microservices_courcse/orders_management_system/inter
 type CreateOrderInfo struct {
 	Items             []models.Item
 	DeliveryOrderInfo models.DeliveryOrderInfo
+	PromoCode         string
+}
+
+// UpdateOrderInfo - new values of order fields listed in Paths, see models.OrderField* constants,
+// other fields are ignored
+type UpdateOrderInfo struct {
+	Paths             []string
+	Items             []models.Item
+	DeliveryOrderInfo models.DeliveryOrderInfo
 }
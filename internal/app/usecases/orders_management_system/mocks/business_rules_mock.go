@@ -1,3 +1,5 @@
+//go:build test
+
 // Code generated by mockery. DO NOT EDIT.
 
 package mocks
@@ -9,17 +11,17 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
-// OrdersStorage is an autogenerated mock type for the OrdersStorage type
-type OrdersStorage struct {
+// BusinessRules is an autogenerated mock type for the BusinessRules type
+type BusinessRules struct {
 	mock.Mock
 }
 
-// CreateOrder provides a mock function with given fields: ctx, order
-func (_m *OrdersStorage) CreateOrder(ctx context.Context, order *models.Order) error {
+// ValidateOrder provides a mock function with given fields: ctx, order
+func (_m *BusinessRules) ValidateOrder(ctx context.Context, order *models.Order) error {
 	ret := _m.Called(ctx, order)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrder")
+		panic("no return value specified for ValidateOrder")
 	}
 
 	var r0 error
@@ -32,13 +34,13 @@ func (_m *OrdersStorage) CreateOrder(ctx context.Context, order *models.Order) e
 	return r0
 }
 
-// NewOrdersStorage creates a new instance of OrdersStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewBusinessRules creates a new instance of BusinessRules. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewOrdersStorage(t interface {
+func NewBusinessRules(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *OrdersStorage {
-	mock := &OrdersStorage{}
+}) *BusinessRules {
+	mock := &BusinessRules{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
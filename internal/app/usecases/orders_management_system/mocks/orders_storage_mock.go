@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	models "github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
 	mock "github.com/stretchr/testify/mock"
@@ -16,17 +17,17 @@ type OrdersStorage struct {
 	mock.Mock
 }
 
-// CreateOrder provides a mock function with given fields: ctx, order
-func (_m *OrdersStorage) CreateOrder(ctx context.Context, order *models.Order) error {
-	ret := _m.Called(ctx, order)
+// AppendOrderEvent provides a mock function with given fields: ctx, order, event
+func (_m *OrdersStorage) AppendOrderEvent(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error {
+	ret := _m.Called(ctx, order, event)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrder")
+		panic("no return value specified for AppendOrderEvent")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Order) error); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Order, *models.OrderDomainEvent) error); ok {
+		r0 = rf(ctx, order, event)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -34,17 +35,17 @@ func (_m *OrdersStorage) CreateOrder(ctx context.Context, order *models.Order) e
 	return r0
 }
 
-// CreateOutboxMessage provides a mock function with given fields: ctx, order
-func (_m *OrdersStorage) CreateOutboxMessage(ctx context.Context, order *models.Order) error {
-	ret := _m.Called(ctx, order)
+// CreateOutboxMessage provides a mock function with given fields: ctx, order, event
+func (_m *OrdersStorage) CreateOutboxMessage(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error {
+	ret := _m.Called(ctx, order, event)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOutboxMessage")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Order) error); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Order, *models.OrderDomainEvent) error); ok {
+		r0 = rf(ctx, order, event)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -52,6 +53,144 @@ func (_m *OrdersStorage) CreateOutboxMessage(ctx context.Context, order *models.
 	return r0
 }
 
+// CreateStatusHistory provides a mock function with given fields: ctx, event
+func (_m *OrdersStorage) CreateStatusHistory(ctx context.Context, event *models.OrderEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatusHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.OrderEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetOrder provides a mock function with given fields: ctx, id
+func (_m *OrdersStorage) GetOrder(ctx context.Context, id models.OrderID) (*models.Order, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID) (*models.Order, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID) *models.Order); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.OrderID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderForUpdate provides a mock function with given fields: ctx, id
+func (_m *OrdersStorage) GetOrderForUpdate(ctx context.Context, id models.OrderID) (*models.Order, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderForUpdate")
+	}
+
+	var r0 *models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID) (*models.Order, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID) *models.Order); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.OrderID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiredOrders provides a mock function with given fields: ctx, now, limit
+func (_m *OrdersStorage) ListExpiredOrders(ctx context.Context, now time.Time, limit uint64) ([]models.OrderID, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiredOrders")
+	}
+
+	var r0 []models.OrderID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) ([]models.OrderID, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) []models.OrderID); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusHistory provides a mock function with given fields: ctx, orderID, afterID
+func (_m *OrdersStorage) ListStatusHistory(ctx context.Context, orderID models.OrderID, afterID int64) ([]models.OrderEvent, error) {
+	ret := _m.Called(ctx, orderID, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusHistory")
+	}
+
+	var r0 []models.OrderEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID, int64) ([]models.OrderEvent, error)); ok {
+		return rf(ctx, orderID, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.OrderID, int64) []models.OrderEvent); ok {
+		r0 = rf(ctx, orderID, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.OrderID, int64) error); ok {
+		r1 = rf(ctx, orderID, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOrdersStorage creates a new instance of OrdersStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrdersStorage(t interface {
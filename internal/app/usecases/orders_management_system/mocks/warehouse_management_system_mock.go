@@ -14,6 +14,24 @@ type WarehouseManagementSystem struct {
 	mock.Mock
 }
 
+// ReleaseStocks provides a mock function with given fields: ctx, key, userID, items
+func (_m *WarehouseManagementSystem) ReleaseStocks(ctx context.Context, key string, userID models.UserID, items []models.Item) error {
+	ret := _m.Called(ctx, key, userID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStocks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UserID, []models.Item) error); ok {
+		r0 = rf(ctx, key, userID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReserveStocks provides a mock function with given fields: ctx, userID, items
 func (_m *WarehouseManagementSystem) ReserveStocks(ctx context.Context, userID models.UserID, items []models.Item) error {
 	ret := _m.Called(ctx, userID, items)
@@ -0,0 +1,48 @@
+package orders_management_system
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// changeOrder - applies change to order, appends it to order event log with orders projection
+// and emits order event, must be called within transaction. Order is not changed when event is not appended
+func (oms *usecase) changeOrder(ctx context.Context, order *models.Order, data models.OrderEventData) error {
+	// order which is not created yet has no status
+	var status models.OrderStatus
+	if order.Version > 0 {
+		status = order.Status
+	}
+
+	event := models.NewOrderDomainEvent(order, data)
+	changed := *order
+	changed.Apply(event)
+
+	if err := oms.OrdersStorage.AppendOrderEvent(ctx, &changed, &event); err != nil {
+		return err
+	}
+	*order = changed
+
+	if err := oms.OrdersStorage.CreateOutboxMessage(ctx, order, &event); err != nil {
+		return err
+	}
+
+	// status history and watchers get status transitions only
+	if order.Status == status {
+		return nil
+	}
+	return oms.emitStatusTransition(ctx, order, event.Type)
+}
+
+// emitStatusTransition - saves transition to status history and notifies watchers after commit, must be called within transaction
+func (oms *usecase) emitStatusTransition(ctx context.Context, order *models.Order, eventType models.OrderEventType) error {
+	event := models.NewOrderEvent(order, eventType)
+	if err := oms.OrdersStorage.CreateStatusHistory(ctx, &event); err != nil {
+		return err
+	}
+
+	oms.OrderEventBus.Publish(ctx, event)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package orders_management_system
+
+import (
+	"context"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// createPaymentIntent - creates payment intent for order total, must be called after all discounts are applied.
+// Intent is created once per order ID
+func (oms *usecase) createPaymentIntent(ctx context.Context, order *models.Order) error {
+	intent, err := oms.Payments.CreatePaymentIntent(ctx, order.ID.String(), order, time.Now().Add(oms.cfg.PaymentTTL))
+	if err != nil {
+		return err
+	}
+
+	order.PaymentOrderInfo = models.PaymentOrderInfo{
+		PaymentID:        intent.ID,
+		PaymentURL:       intent.ConfirmationURL,
+		PaymentExpiresAt: intent.ExpiresAt,
+	}
+
+	return nil
+}
+
+// replacePaymentIntent - creates payment intent for changed order total keeping payment deadline,
+// previous intent can not confirm the order anymore
+func (oms *usecase) replacePaymentIntent(ctx context.Context, key string, order *models.Order) error {
+	intent, err := oms.Payments.CreatePaymentIntent(ctx, key, order, order.PaymentExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	order.PaymentOrderInfo = models.PaymentOrderInfo{
+		PaymentID:        intent.ID,
+		PaymentURL:       intent.ConfirmationURL,
+		PaymentExpiresAt: intent.ExpiresAt,
+	}
+
+	return nil
+}
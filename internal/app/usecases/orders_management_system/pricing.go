@@ -0,0 +1,53 @@
+package orders_management_system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// priceOrder - fills unit prices, line totals and order totals from pricing quote
+func (oms *usecase) priceOrder(ctx context.Context, order *models.Order) error {
+	quote, err := oms.Pricing.QuotePrices(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	var subtotal models.Money
+	for i := range order.Items {
+		unitPrice, ok := quote.UnitPrices[order.Items[i].SKU.ID]
+		if !ok {
+			return fmt.Errorf("no price for SKU %d", order.Items[i].SKU.ID)
+		}
+
+		order.Items[i].UnitPrice = unitPrice
+		if order.Items[i].Total, err = unitPrice.Multiply(order.Items[i].Quantity); err != nil {
+			return err
+		}
+
+		if subtotal, err = subtotal.Add(order.Items[i].Total); err != nil {
+			return err
+		}
+	}
+
+	total, err := subtotal.Add(quote.DeliveryCost)
+	if err != nil {
+		return err
+	}
+
+	deliveryCost := quote.DeliveryCost
+	if deliveryCost.CurrencyCode == "" {
+		deliveryCost.CurrencyCode = total.CurrencyCode // free delivery
+	}
+
+	order.OrderPricing = models.OrderPricing{
+		CurrencyCode: total.CurrencyCode,
+		Subtotal:     subtotal,
+		DeliveryCost: deliveryCost,
+		Discount:     models.Money{CurrencyCode: total.CurrencyCode},
+		Total:        total,
+	}
+
+	return nil
+}
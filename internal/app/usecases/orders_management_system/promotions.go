@@ -0,0 +1,109 @@
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// applyPromoCode - applies promo code discount to order pricing, usage limit is checked without lock,
+// so concurrent orders may pass the check, see redeemPromoCode
+func (oms *usecase) applyPromoCode(ctx context.Context, order *models.Order, code string) error {
+	_, err := oms.usePromoCode(ctx, order, code, false)
+	return err
+}
+
+// redeemPromoCode - applies promo code discount to order pricing and returns redemption of the order,
+// usage limit is guaranteed as redemptions of the user are locked, must be called within transaction
+// which creates the redemption
+func (oms *usecase) redeemPromoCode(ctx context.Context, order *models.Order, code string) (*models.PromoRedemption, error) {
+	return oms.usePromoCode(ctx, order, code, true)
+}
+
+func (oms *usecase) usePromoCode(ctx context.Context, order *models.Order, code string, lock bool) (*models.PromoRedemption, error) {
+	invalidCode := func(description string) error {
+		return &models.ValidationError{
+			Violations: []models.FieldViolation{{
+				Field:       "promo_code",
+				Description: description,
+			}},
+		}
+	}
+
+	campaign, err := oms.PromotionsStorage.GetCampaignByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, invalidCode(fmt.Sprintf("promo code %q does not exist", code))
+		}
+		return nil, err
+	}
+
+	if !campaign.IsActive(time.Now()) {
+		return nil, invalidCode(fmt.Sprintf("promo code %q is expired or not active yet", code))
+	}
+
+	if campaign.UsageLimitPerUser > 0 {
+		if lock {
+			if err = oms.PromotionsStorage.LockRedemptions(ctx, campaign.ID, order.UserID); err != nil {
+				return nil, err
+			}
+		}
+		used, err := oms.PromotionsStorage.CountRedemptions(ctx, campaign.ID, order.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if used >= campaign.UsageLimitPerUser {
+			return nil, invalidCode(fmt.Sprintf("promo code %q usage limit is exceeded", code))
+		}
+	}
+
+	if err = applyDiscount(order, campaign); err != nil {
+		if errors.Is(err, models.ErrCurrencyMismatch) {
+			return nil, invalidCode(fmt.Sprintf("promo code %q is not applicable to order currency", code))
+		}
+		return nil, err
+	}
+
+	return &models.PromoRedemption{
+		CampaignID: campaign.ID,
+		UserID:     order.UserID,
+		OrderID:    order.ID,
+		Discount:   order.Discount,
+	}, nil
+}
+
+// reapplyPromoCode - recalculates discount of promo code already redeemed by order after its subtotal is changed,
+// redemption limits and campaign period are not checked again
+func (oms *usecase) reapplyPromoCode(ctx context.Context, order *models.Order, code string) error {
+	campaign, err := oms.PromotionsStorage.GetCampaignByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	return applyDiscount(order, campaign)
+}
+
+// applyDiscount - sets campaign discount of order subtotal and recalculates order total
+func applyDiscount(order *models.Order, campaign *models.PromoCampaign) error {
+	discount, err := campaign.Discount(order.Subtotal)
+	if err != nil {
+		return err
+	}
+
+	total, err := order.Subtotal.Add(discount.Negate())
+	if err != nil {
+		return err
+	}
+	if total, err = total.Add(order.DeliveryCost); err != nil {
+		return err
+	}
+
+	order.Discount = discount
+	order.PromoCode = campaign.Code
+	order.Total = total
+
+	return nil
+}
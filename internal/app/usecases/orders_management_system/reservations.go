@@ -0,0 +1,38 @@
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+)
+
+// reservationKey - idempotency key of release of reservations freed by change of the order
+func reservationKey(orderID models.OrderID, change string) string {
+	return orderID.String() + "/" + change
+}
+
+// releaseReservations - returns stocks and delivery slot of release, calls are idempotent by release key
+func (oms *usecase) releaseReservations(ctx context.Context, release models.ReservationRelease) error {
+	var errs []error
+	if len(release.Items) > 0 {
+		if err := oms.WarehouseManagementSystem.ReleaseStocks(ctx, release.Key, release.UserID, release.Items); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if release.DeliverySlotID != 0 {
+		if err := oms.DeliveryService.ReleaseDeliverySlot(ctx, release.Key, release.UserID, release.DeliveryVariantID, release.DeliverySlotID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compensate - releases reservations of the change which failed with err, returns err joined with release error.
+// Release is not bound to ctx so that it is done even when caller is gone
+func (oms *usecase) compensate(ctx context.Context, err error, release models.ReservationRelease) error {
+	if releaseErr := oms.releaseReservations(context.WithoutCancel(ctx), release); releaseErr != nil {
+		return errors.Join(err, releaseErr)
+	}
+	return err
+}
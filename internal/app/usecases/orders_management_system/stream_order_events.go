@@ -0,0 +1,63 @@
+package orders_management_system
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// GetOrder - returns order by id
+func (oms *usecase) GetOrder(ctx context.Context, orderID models.OrderID) (*models.Order, error) {
+	const api = "orders_management_system.usecase.GetOrder"
+
+	order, err := oms.OrdersStorage.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return order, nil
+}
+
+// StreamOrderEvents - calls fn with every status transition of order after lastEventID
+// (replayed from status history) and then with live transitions until ctx is done
+func (oms *usecase) StreamOrderEvents(ctx context.Context, orderID models.OrderID, lastEventID int64, fn func(models.OrderEvent) error) error {
+	const api = "orders_management_system.usecase.StreamOrderEvents"
+
+	// subscribe before reading history so that no transition is missed in between
+	events, unsubscribe := oms.OrderEventBus.Subscribe(orderID)
+	defer unsubscribe()
+
+	history, err := oms.OrdersStorage.ListStatusHistory(ctx, orderID, lastEventID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	last := lastEventID
+	for _, event := range history {
+		if err = fn(event); err != nil {
+			return pkgerrors.Wrap(api, err)
+		}
+		last = event.ID
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return pkgerrors.Wrap(api, models.ErrWatchLagged)
+			}
+			// already replayed from history
+			if event.ID <= last {
+				continue
+			}
+			last = event.ID
+
+			if err = fn(event); err != nil {
+				return pkgerrors.Wrap(api, err)
+			}
+		}
+	}
+}
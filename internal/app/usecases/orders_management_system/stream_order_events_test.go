@@ -0,0 +1,130 @@
+//go:build test
+
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_usecase_StreamOrderEvents(t *testing.T) {
+	var (
+		orderID    = models.OrderID(uuid.New())
+		errStorage = errors.New("storage is down")
+
+		created   = models.OrderEvent{ID: 1, OrderID: orderID, Type: models.OrderEventCreated, Status: models.OrderStatusAwaitingPayment}
+		paid      = models.OrderEvent{ID: 2, OrderID: orderID, Type: models.OrderEventPaid, Status: models.OrderStatusPaid}
+		cancelled = models.OrderEvent{ID: 3, OrderID: orderID, Type: models.OrderEventCancelled, Status: models.OrderStatusCancelled}
+	)
+
+	type fields struct {
+		OrdersStorage *mocks.OrdersStorage
+		OrderEventBus *mocks.OrderEventBus
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID int64
+		// events - published to subscriber, channel is closed after them when closeEvents is set
+		events      []models.OrderEvent
+		closeEvents bool
+		want        []models.OrderEvent
+		wantErr     error
+
+		on func(*fields)
+	}{
+		{
+			name:   "Test 1. Positive. History is replayed, live duplicates are skipped.",
+			events: []models.OrderEvent{paid, cancelled},
+			want:   []models.OrderEvent{created, paid, cancelled},
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListStatusHistory", mock.Anything, orderID, int64(0)).
+					Return([]models.OrderEvent{created, paid}, nil)
+			},
+		},
+		{
+			name:        "Test 2. Positive. Resumed after Last-Event-ID.",
+			lastEventID: 2,
+			events:      []models.OrderEvent{cancelled},
+			want:        []models.OrderEvent{cancelled},
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListStatusHistory", mock.Anything, orderID, int64(2)).
+					Return([]models.OrderEvent{}, nil)
+			},
+		},
+		{
+			name:        "Test 3. Negative. Subscriber fell behind.",
+			closeEvents: true,
+			want:        []models.OrderEvent{created},
+			wantErr:     models.ErrWatchLagged,
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListStatusHistory", mock.Anything, orderID, int64(0)).
+					Return([]models.OrderEvent{created}, nil)
+			},
+		},
+		{
+			name:    "Test 4. Negative. History is unavailable.",
+			wantErr: errStorage,
+			on: func(f *fields) {
+				f.OrdersStorage.On("ListStatusHistory", mock.Anything, orderID, int64(0)).
+					Return(nil, errStorage)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f := &fields{
+				OrdersStorage: mocks.NewOrdersStorage(t),
+				OrderEventBus: mocks.NewOrderEventBus(t),
+			}
+
+			events := make(chan models.OrderEvent, len(tt.events))
+			for _, event := range tt.events {
+				events <- event
+			}
+			if tt.closeEvents {
+				close(events)
+			}
+
+			unsubscribed := false
+			f.OrderEventBus.On("Subscribe", orderID).
+				Return((<-chan models.OrderEvent)(events), func() { unsubscribed = true })
+
+			oms := &usecase{
+				Deps: Deps{
+					OrdersStorage: f.OrdersStorage,
+					OrderEventBus: f.OrderEventBus,
+				},
+			}
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			var got []models.OrderEvent
+			err := oms.StreamOrderEvents(ctx, orderID, tt.lastEventID, func(event models.OrderEvent) error {
+				got = append(got, event)
+				if len(got) == len(tt.want) && !tt.closeEvents {
+					cancel() // client went away
+				}
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.True(t, unsubscribed)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package orders_management_system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+// UpdateOrder - changes items and delivery of order awaiting payment as field mask of info says,
+// order is repriced, stocks are re-reserved by difference of quantities. Update without changes is no-op.
+// Order is changed only when it has expectedVersion, 0 - any version. Reservations and payment intent
+// of updated order are made before transaction, so concurrent change of the order fails with models.ErrVersionConflict
+func (oms *usecase) UpdateOrder(ctx context.Context, orderID models.OrderID, info UpdateOrderInfo, expectedVersion int64) (*models.Order, error) {
+	const api = "orders_management_system.usecase.UpdateOrder"
+
+	order, err := oms.OrdersStorage.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	if !order.Status.IsUpdatable() {
+		return nil, pkgerrors.Wrap(api, fmt.Errorf("order is %s: %w", order.Status, models.ErrInvalidOrderStatus))
+	}
+
+	if expectedVersion != 0 && order.Version != expectedVersion {
+		return nil, pkgerrors.Wrap(api, fmt.Errorf("order version is %d, expected %d: %w", order.Version, expectedVersion, models.ErrVersionConflict))
+	}
+
+	updated, fields, err := applyUpdateMask(order, info)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	if len(fields) == 0 {
+		return order, nil // nothing is changed
+	}
+
+	// reservations of updated order are returned when the order is not updated
+	reserved, err := oms.prepareUpdatedOrder(ctx, order, updated)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	total := updated.Total
+
+	var current *models.Order
+	err = oms.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			var err error
+			if current, err = oms.OrdersStorage.GetOrderForUpdate(txCtx, orderID); err != nil {
+				return err
+			}
+
+			// order is updated only from the version it is prepared from
+			if current.Version != order.Version {
+				return fmt.Errorf("order version is %d, prepared from %d: %w", current.Version, order.Version, models.ErrVersionConflict)
+			}
+
+			if order.PromoCode != "" {
+				if err = oms.reapplyPromoCode(txCtx, updated, order.PromoCode); err != nil {
+					return err
+				}
+				if updated.Total != total {
+					return &models.ValidationError{
+						Violations: []models.FieldViolation{{
+							Field:       "promo_code",
+							Description: fmt.Sprintf("promo code %q is changed, try again", order.PromoCode),
+						}},
+					}
+				}
+				// redemption records discount the order actually gets
+				if updated.Discount != order.Discount {
+					if err = oms.PromotionsStorage.UpdateRedemptionDiscount(txCtx, order.ID, updated.Discount); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err = oms.changeOrder(txCtx, current, models.OrderUpdatedData{
+				Fields:   fields,
+				Items:    updated.Items,
+				Delivery: updated.DeliveryOrderInfo,
+				Pricing:  updated.OrderPricing,
+				Payment:  updated.PaymentOrderInfo,
+			}); err != nil {
+				return err
+			}
+			return oms.AuditLog.Record(txCtx, models.AuditActionUpdateOrder, order, current)
+		},
+	)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, oms.compensate(ctx, err, reserved))
+	}
+
+	// reservations freed by the update are released after commit, order is updated even when release fails
+	_, freed := stockDeltas(order.Items, updated.Items)
+	release := models.ReservationRelease{
+		Key:     reservationKey(order.ID, fmt.Sprintf("update/%d", order.Version+1)),
+		OrderID: order.ID,
+		UserID:  order.UserID,
+		Items:   freed,
+	}
+	if updated.DeliveryVariantID != order.DeliveryVariantID || updated.DeliverySlotID != order.DeliverySlotID {
+		release.DeliveryVariantID, release.DeliverySlotID = order.DeliveryVariantID, order.DeliverySlotID
+	}
+	if err = oms.releaseReservations(ctx, release); err != nil {
+		logger.ErrorKV(ctx, "release reservations freed by order update",
+			"order_id", order.ID.String(),
+			"error", err,
+		)
+	}
+
+	return current, nil
+}
+
+// applyUpdateMask - returns copy of order with fields of info.Paths replaced and fields which are actually changed
+func applyUpdateMask(order *models.Order, info UpdateOrderInfo) (*models.Order, []string, error) {
+	updated := *order
+	updated.Items = append([]models.Item(nil), order.Items...)
+
+	var violations []models.FieldViolation
+	for _, path := range info.Paths {
+		switch path {
+		case models.OrderFieldItems:
+			updated.Items = append([]models.Item(nil), info.Items...)
+		case models.OrderFieldDeliveryInfo:
+			updated.DeliveryVariantID = info.DeliveryOrderInfo.DeliveryVariantID
+			updated.DeliveryDate = info.DeliveryOrderInfo.DeliveryDate
+		case models.OrderFieldDeliveryVariantID:
+			updated.DeliveryVariantID = info.DeliveryOrderInfo.DeliveryVariantID
+		case models.OrderFieldDeliveryDate:
+			updated.DeliveryDate = info.DeliveryOrderInfo.DeliveryDate
+		default:
+			violations = append(violations, models.FieldViolation{
+				Field:       "update_mask",
+				Description: fmt.Sprintf("field %q can not be updated", path),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, nil, &models.ValidationError{Violations: violations}
+	}
+
+	var fields []string
+	if !sameItems(order.Items, updated.Items) {
+		fields = append(fields, models.OrderFieldItems)
+	}
+	if order.DeliveryVariantID != updated.DeliveryVariantID {
+		fields = append(fields, models.OrderFieldDeliveryVariantID)
+	}
+	if !order.DeliveryDate.Equal(updated.DeliveryDate) {
+		fields = append(fields, models.OrderFieldDeliveryDate)
+	}
+
+	return &updated, fields, nil
+}
+
+// prepareUpdatedOrder - validates updated order as a new one, reprices it keeping promo code of the order,
+// reserves added stocks and new delivery slot and replaces payment intent when total is changed.
+// Returns reservations to release when the order is not updated, they are released on failure here
+func (oms *usecase) prepareUpdatedOrder(ctx context.Context, order, updated *models.Order) (models.ReservationRelease, error) {
+	// every attempt to update the order releases its own reservations
+	reserved := models.ReservationRelease{
+		Key:     reservationKey(order.ID, fmt.Sprintf("update/%d/%s", order.Version+1, uuid.New())),
+		OrderID: order.ID,
+		UserID:  order.UserID,
+	}
+
+	if err := oms.enrichItems(ctx, updated.Items); err != nil {
+		return reserved, err
+	}
+
+	if err := oms.BusinessRules.ValidateOrder(ctx, updated); err != nil {
+		return reserved, err
+	}
+
+	slot, err := oms.findDeliverySlot(ctx, updated)
+	if err != nil {
+		return reserved, err
+	}
+
+	if err = oms.priceOrder(ctx, updated); err != nil {
+		return reserved, err
+	}
+	// promo code is applied again within transaction, so that total matches campaign at commit
+	if order.PromoCode != "" {
+		if err = oms.reapplyPromoCode(ctx, updated, order.PromoCode); err != nil {
+			return reserved, err
+		}
+	}
+
+	if reserve, _ := stockDeltas(order.Items, updated.Items); len(reserve) > 0 {
+		if err = oms.WarehouseManagementSystem.ReserveStocks(ctx, order.UserID, reserve); err != nil {
+			return reserved, err
+		}
+		reserved.Items = reserve
+	}
+
+	if updated.DeliveryVariantID != order.DeliveryVariantID || slot.ID != order.DeliverySlotID {
+		if err = oms.DeliveryService.ReserveDeliverySlot(ctx, updated.UserID, updated.DeliveryVariantID, slot.ID); err != nil {
+			return reserved, oms.compensate(ctx, err, reserved)
+		}
+		reserved.DeliveryVariantID, reserved.DeliverySlotID = updated.DeliveryVariantID, slot.ID
+	}
+	updated.DeliverySlotID = slot.ID
+
+	// intent is created once per version and total of the order, intent of not updated order expires unpaid
+	if updated.Total != order.Total {
+		key := fmt.Sprintf("%s/update/%d/%s", order.ID, order.Version+1, updated.Total)
+		if err = oms.replacePaymentIntent(ctx, key, updated); err != nil {
+			return reserved, oms.compensate(ctx, err, reserved)
+		}
+	}
+
+	return reserved, nil
+}
+
+// sameItems - reports whether items have the same SKUs, quantities and warehouses in the same order
+func sameItems(a, b []models.Item) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].SKU.ID != b[i].SKU.ID || a[i].Quantity != b[i].Quantity || a[i].WarehouseID != b[i].WarehouseID {
+			return false
+		}
+	}
+	return true
+}
+
+// stockDeltas - returns quantities of SKUs in warehouses to reserve in addition and to release
+// when order items are changed from before to after
+func stockDeltas(before, after []models.Item) (reserve, release []models.Item) {
+	type stock struct {
+		skuID       models.SKUID
+		warehouseID models.WarehouseID
+	}
+
+	quantities := make(map[stock]int64, len(before)+len(after))
+	for _, item := range before {
+		quantities[stock{item.SKU.ID, item.WarehouseID}] -= int64(item.Quantity)
+	}
+	for _, item := range after {
+		quantities[stock{item.SKU.ID, item.WarehouseID}] += int64(item.Quantity)
+	}
+
+	// items are walked in order of the order so that calls of warehouse management system are deterministic
+	for _, item := range after {
+		key := stock{item.SKU.ID, item.WarehouseID}
+		if delta := quantities[key]; delta > 0 {
+			reserve = append(reserve, models.Item{SKU: item.SKU, Quantity: uint32(delta), WarehouseID: item.WarehouseID})
+			quantities[key] = 0
+		}
+	}
+	for _, item := range before {
+		key := stock{item.SKU.ID, item.WarehouseID}
+		if delta := quantities[key]; delta < 0 {
+			release = append(release, models.Item{SKU: item.SKU, Quantity: uint32(-delta), WarehouseID: item.WarehouseID})
+			quantities[key] = 0
+		}
+	}
+
+	return reserve, release
+}
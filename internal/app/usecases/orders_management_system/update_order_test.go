@@ -0,0 +1,606 @@
+//go:build test
+
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_usecase_UpdateOrder(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		date    = time.Now()
+		orderID = models.OrderID(uuid.New())
+		errWMS  = errors.New("warehouse management system is unavailable")
+
+		errPayments = errors.New("payments service is unavailable")
+
+		catalogSKUs = []models.CatalogSKU{
+			{SKU: models.SKU{ID: 2, Name: "Item 2"}, Available: true},
+			{SKU: models.SKU{ID: 3, Name: "Item 3"}, Available: true},
+		}
+
+		priceQuote = &models.PriceQuote{
+			UnitPrices: map[models.SKUID]models.Money{
+				2: {CurrencyCode: "RUB", Units: 10, Nanos: 500_000_000},
+				3: {CurrencyCode: "RUB", Units: 100},
+			},
+			DeliveryCost: models.Money{CurrencyCode: "RUB", Units: 300},
+		}
+
+		deliveryVariant = &models.DeliveryVariant{
+			ID:           5,
+			WarehouseIDs: []models.WarehouseID{4},
+			Slots: []models.DeliverySlot{
+				{ID: 7, From: date.Add(-time.Hour), To: date.Add(time.Hour), Available: true},
+				{ID: 8, From: date.Add(time.Hour), To: date.Add(3 * time.Hour), Available: true},
+			},
+		}
+
+		promoCampaign = &models.PromoCampaign{
+			ID:           9,
+			Code:         "SALE10",
+			DiscountType: models.DiscountTypePercentage,
+			PercentOff:   1000, // 10%
+			ValidFrom:    date.Add(-time.Hour),
+			ValidTo:      date.Add(time.Hour),
+		}
+
+		paymentIntent = &models.PaymentIntent{
+			ID:              "pi_2",
+			ConfirmationURL: "https://pay.example.com/pi_2",
+			ExpiresAt:       date.Add(15 * time.Minute),
+		}
+	)
+
+	newOrder := func(status models.OrderStatus) *models.Order {
+		return &models.Order{
+			ID:      orderID,
+			Version: 2,
+			UserID:  1,
+			Status:  status,
+			Items: []models.Item{
+				{
+					SKU:         models.SKU{ID: 2, Name: "Item 2"},
+					Quantity:    3,
+					WarehouseID: 4,
+					UnitPrice:   models.Money{CurrencyCode: "RUB", Units: 10, Nanos: 500_000_000},
+					Total:       models.Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+				},
+			},
+			DeliveryOrderInfo: models.DeliveryOrderInfo{
+				DeliveryVariantID: 5,
+				DeliveryDate:      date,
+				DeliverySlotID:    7,
+			},
+			OrderPricing: models.OrderPricing{
+				CurrencyCode: "RUB",
+				Subtotal:     models.Money{CurrencyCode: "RUB", Units: 31, Nanos: 500_000_000},
+				DeliveryCost: models.Money{CurrencyCode: "RUB", Units: 300},
+				Discount:     models.Money{CurrencyCode: "RUB"},
+				Total:        models.Money{CurrencyCode: "RUB", Units: 331, Nanos: 500_000_000},
+			},
+			PaymentOrderInfo: models.PaymentOrderInfo{
+				PaymentID:        "pi_1",
+				PaymentURL:       "https://pay.example.com/pi_1",
+				PaymentExpiresAt: date.Add(15 * time.Minute),
+			},
+		}
+	}
+
+	// updatedEvent - matches order.updated event with changed fields
+	updatedEvent := func(fields ...string) interface{} {
+		return mock.MatchedBy(func(event *models.OrderDomainEvent) bool {
+			data, ok := event.Data.(models.OrderUpdatedData)
+			return ok && event.Version == 3 && assert.ObjectsAreEqual(fields, data.Fields)
+		})
+	}
+
+	// keyOf - matches idempotency key of the change of the order, "<order id>/<change>/..."
+	keyOf := func(change string) interface{} {
+		return mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, orderID.String()+"/"+change+"/")
+		})
+	}
+
+	type fields struct {
+		WarehouseManagementSystem *mocks.WarehouseManagementSystem
+		OrdersStorage             *mocks.OrdersStorage
+		Catalog                   *mocks.Catalog
+		DeliveryService           *mocks.DeliveryService
+		Pricing                   *mocks.Pricing
+		Payments                  *mocks.Payments
+		PromotionsStorage         *mocks.PromotionsStorage
+		BusinessRules             *mocks.BusinessRules
+		TransactionManager        *mocks.TransactionManager
+		OrderEventBus             *mocks.OrderEventBus
+		AuditLog                  *mocks.AuditLog
+
+		// inTx - reports whether transaction is running
+		inTx bool
+		// calledInTx - reports whether reservations or payments were called within transaction
+		calledInTx bool
+		// redeemedInTx - reports whether redemption of promo code was updated within transaction
+		redeemedInTx bool
+	}
+
+	// outOfTx - records call made within transaction
+	outOfTx := func(f *fields) func(mock.Arguments) {
+		return func(mock.Arguments) { f.calledInTx = f.calledInTx || f.inTx }
+	}
+
+	// onPrepared - expects updated order to be validated and priced
+	onPrepared := func(f *fields) {
+		f.OrdersStorage.On("GetOrder", ctx, orderID).
+			Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+		f.Catalog.On("GetSKUs", ctx, mock.Anything).
+			Return(catalogSKUs, nil)
+		f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+			Return(nil)
+		f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+			Return(deliveryVariant, nil)
+		f.Pricing.On("QuotePrices", ctx, mock.Anything).
+			Return(priceQuote, nil)
+	}
+
+	// onUpdated - expects order to be validated, priced and saved as updated
+	onUpdated := func(f *fields, eventFields ...string) {
+		onPrepared(f)
+		f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+			Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+		f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, updatedEvent(eventFields...)).
+			Return(nil)
+		f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, updatedEvent(eventFields...)).
+			Return(nil)
+		// status is not changed: neither status history nor watchers get the event
+		f.AuditLog.On("Record", ctx, models.AuditActionUpdateOrder, mock.Anything, mock.Anything).
+			Return(nil)
+	}
+
+	tests := []struct {
+		name            string
+		info            UpdateOrderInfo
+		expectedVersion int64
+		want            func() *models.Order
+		wantErr         error
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Quantity is increased.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+			},
+			expectedVersion: 2,
+			want: func() *models.Order {
+				order := newOrder(models.OrderStatusAwaitingPayment)
+				order.Version = 3
+				order.Items[0].Quantity = 5
+				order.Items[0].Total = models.Money{CurrencyCode: "RUB", Units: 52, Nanos: 500_000_000}
+				order.Subtotal = models.Money{CurrencyCode: "RUB", Units: 52, Nanos: 500_000_000}
+				order.Total = models.Money{CurrencyCode: "RUB", Units: 352, Nanos: 500_000_000}
+				order.PaymentOrderInfo = models.PaymentOrderInfo{
+					PaymentID:        "pi_2",
+					PaymentURL:       "https://pay.example.com/pi_2",
+					PaymentExpiresAt: date.Add(15 * time.Minute),
+				}
+				return order
+			},
+
+			on: func(f *fields) {
+				onUpdated(f, models.OrderFieldItems)
+				// intent is created once per version and total of the order
+				f.Payments.On("CreatePaymentIntent", ctx, orderID.String()+"/update/3/352.500000000", mock.Anything, date.Add(15*time.Minute)).
+					Return(paymentIntent, nil).
+					Run(outOfTx(f))
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
+					{SKU: models.SKU{ID: 2, Name: "Item 2"}, Quantity: 2, WarehouseID: 4},
+				}).
+					Return(nil).
+					Run(outOfTx(f))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 0)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 0)
+				assert.False(t, f.calledInTx, "payment intent and stocks must be reserved before transaction")
+			},
+		},
+		{
+			name: "Test 2. Positive. Delivery date is changed, old slot is released after commit.",
+			info: UpdateOrderInfo{
+				Paths:             []string{models.OrderFieldDeliveryDate},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{DeliveryDate: date.Add(2 * time.Hour)},
+			},
+			want: func() *models.Order {
+				order := newOrder(models.OrderStatusAwaitingPayment)
+				order.Version = 3
+				order.DeliveryDate = date.Add(2 * time.Hour)
+				order.DeliverySlotID = 8
+				return order
+			},
+
+			on: func(f *fields) {
+				onUpdated(f, models.OrderFieldDeliveryDate)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(8)).
+					Return(nil).
+					Run(outOfTx(f))
+				f.DeliveryService.On("ReleaseDeliverySlot", ctx, orderID.String()+"/update/3", models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(7)).
+					Return(nil).
+					Run(outOfTx(f))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.Payments.AssertNumberOfCalls(t, "CreatePaymentIntent", 0)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReserveStocks", 0)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 0)
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 1)
+				assert.False(t, f.calledInTx, "slots must be reserved before transaction and released after commit")
+			},
+		},
+		{
+			name: "Test 3. Positive. Nothing is changed.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldDeliveryVariantID},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryVariantID: 5,
+				},
+			},
+			want: func() *models.Order {
+				return newOrder(models.OrderStatusAwaitingPayment)
+			},
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 0)
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 0)
+			},
+		},
+		{
+			name: "Test 4. Negative. Order is paid.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+			},
+			wantErr: models.ErrInvalidOrderStatus,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", ctx, orderID).
+					Return(newOrder(models.OrderStatusPaid), nil)
+			},
+		},
+		{
+			name: "Test 5. Negative. Version conflict.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+			},
+			expectedVersion: 1,
+			wantErr:         models.ErrVersionConflict,
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+			},
+		},
+		{
+			name: "Test 6. Positive. SKU is replaced, removed stocks are released after commit even when release fails.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems},
+				Items: []models.Item{{SKU: models.SKU{ID: 3}, Quantity: 1, WarehouseID: 4}},
+			},
+			want: func() *models.Order {
+				order := newOrder(models.OrderStatusAwaitingPayment)
+				order.Version = 3
+				order.Items = []models.Item{{
+					SKU:         models.SKU{ID: 3, Name: "Item 3"},
+					Quantity:    1,
+					WarehouseID: 4,
+					UnitPrice:   models.Money{CurrencyCode: "RUB", Units: 100},
+					Total:       models.Money{CurrencyCode: "RUB", Units: 100},
+				}}
+				order.Subtotal = models.Money{CurrencyCode: "RUB", Units: 100}
+				order.Total = models.Money{CurrencyCode: "RUB", Units: 400}
+				order.PaymentOrderInfo = models.PaymentOrderInfo{
+					PaymentID:        "pi_2",
+					PaymentURL:       "https://pay.example.com/pi_2",
+					PaymentExpiresAt: date.Add(15 * time.Minute),
+				}
+				return order
+			},
+
+			on: func(f *fields) {
+				onUpdated(f, models.OrderFieldItems)
+				f.Payments.On("CreatePaymentIntent", ctx, mock.Anything, mock.Anything, date.Add(15*time.Minute)).
+					Return(paymentIntent, nil)
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
+					{SKU: models.SKU{ID: 3, Name: "Item 3"}, Quantity: 1, WarehouseID: 4},
+				}).
+					Return(nil)
+				f.WarehouseManagementSystem.On("ReleaseStocks", ctx, orderID.String()+"/update/3", models.UserID(1), []models.Item{
+					{SKU: models.SKU{ID: 2, Name: "Item 2"}, Quantity: 3, WarehouseID: 4},
+				}).
+					Return(errWMS).
+					Run(outOfTx(f))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				assert.False(t, f.calledInTx, "removed stocks must be released after commit")
+			},
+		},
+		{
+			name: "Test 7. Negative. Field can not be updated.",
+			info: UpdateOrderInfo{
+				Paths: []string{"status"},
+			},
+			wantErr: &models.ValidationError{},
+
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", ctx, orderID).
+					Return(newOrder(models.OrderStatusAwaitingPayment), nil)
+			},
+		},
+		{
+			name: "Test 8. Negative. Order is changed concurrently, new slot and stocks are released.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems, models.OrderFieldDeliveryDate},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryDate: date.Add(2 * time.Hour),
+				},
+			},
+			wantErr: models.ErrVersionConflict,
+
+			on: func(f *fields) {
+				onPrepared(f)
+				changed := newOrder(models.OrderStatusAwaitingPayment)
+				changed.Version = 3
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(changed, nil)
+				reserved := []models.Item{{SKU: models.SKU{ID: 2, Name: "Item 2"}, Quantity: 2, WarehouseID: 4}}
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), reserved).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(8)).
+					Return(nil)
+				f.Payments.On("CreatePaymentIntent", ctx, mock.Anything, mock.Anything, date.Add(15*time.Minute)).
+					Return(paymentIntent, nil)
+				f.WarehouseManagementSystem.On("ReleaseStocks", mock.Anything, keyOf("update/3"), models.UserID(1), reserved).
+					Return(nil).
+					Run(outOfTx(f))
+				f.DeliveryService.On("ReleaseDeliverySlot", mock.Anything, keyOf("update/3"), models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(8)).
+					Return(nil).
+					Run(outOfTx(f))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OrdersStorage.AssertNumberOfCalls(t, "AppendOrderEvent", 0)
+				f.WarehouseManagementSystem.AssertNumberOfCalls(t, "ReleaseStocks", 1)
+				// old slot of the order is kept
+				f.DeliveryService.AssertNumberOfCalls(t, "ReleaseDeliverySlot", 1)
+				assert.False(t, f.calledInTx, "reservations must be released after rollback")
+			},
+		},
+		{
+			name: "Test 9. Negative. Payment intent is not created, new slot and stocks are released.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems, models.OrderFieldDeliveryDate},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+				DeliveryOrderInfo: models.DeliveryOrderInfo{
+					DeliveryDate: date.Add(2 * time.Hour),
+				},
+			},
+			wantErr: errPayments,
+
+			on: func(f *fields) {
+				onPrepared(f)
+				reserved := []models.Item{{SKU: models.SKU{ID: 2, Name: "Item 2"}, Quantity: 2, WarehouseID: 4}}
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), reserved).
+					Return(nil)
+				f.DeliveryService.On("ReserveDeliverySlot", ctx, models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(8)).
+					Return(nil)
+				f.Payments.On("CreatePaymentIntent", ctx, mock.Anything, mock.Anything, date.Add(15*time.Minute)).
+					Return(nil, errPayments)
+				f.WarehouseManagementSystem.On("ReleaseStocks", mock.Anything, keyOf("update/3"), models.UserID(1), reserved).
+					Return(nil)
+				f.DeliveryService.On("ReleaseDeliverySlot", mock.Anything, keyOf("update/3"), models.UserID(1), models.DeliveryVariantID(5), models.DeliverySlotID(8)).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TransactionManager.AssertNumberOfCalls(t, "RunReadCommitted", 0)
+			},
+		},
+		{
+			name: "Test 10. Positive. Order with promo code is repriced, discount of redemption follows it.",
+			info: UpdateOrderInfo{
+				Paths: []string{models.OrderFieldItems},
+				Items: []models.Item{{SKU: models.SKU{ID: 2}, Quantity: 5, WarehouseID: 4}},
+			},
+			expectedVersion: 2,
+			want: func() *models.Order {
+				order := newOrder(models.OrderStatusAwaitingPayment)
+				order.Version = 3
+				order.Items[0].Quantity = 5
+				order.Items[0].Total = models.Money{CurrencyCode: "RUB", Units: 52, Nanos: 500_000_000}
+				order.Subtotal = models.Money{CurrencyCode: "RUB", Units: 52, Nanos: 500_000_000}
+				order.Discount = models.Money{CurrencyCode: "RUB", Units: 5, Nanos: 250_000_000}
+				order.PromoCode = "SALE10"
+				order.Total = models.Money{CurrencyCode: "RUB", Units: 347, Nanos: 250_000_000}
+				order.PaymentOrderInfo = models.PaymentOrderInfo{
+					PaymentID:        "pi_2",
+					PaymentURL:       "https://pay.example.com/pi_2",
+					PaymentExpiresAt: date.Add(15 * time.Minute),
+				}
+				return order
+			},
+
+			on: func(f *fields) {
+				discounted := func() *models.Order {
+					order := newOrder(models.OrderStatusAwaitingPayment)
+					order.Discount = models.Money{CurrencyCode: "RUB", Units: 3, Nanos: 150_000_000}
+					order.PromoCode = "SALE10"
+					order.Total = models.Money{CurrencyCode: "RUB", Units: 328, Nanos: 350_000_000}
+					return order
+				}
+				f.OrdersStorage.On("GetOrder", ctx, orderID).
+					Return(discounted(), nil)
+				f.Catalog.On("GetSKUs", ctx, mock.Anything).
+					Return(catalogSKUs, nil)
+				f.BusinessRules.On("ValidateOrder", ctx, mock.Anything).
+					Return(nil)
+				f.DeliveryService.On("GetDeliveryVariant", ctx, models.DeliveryVariantID(5)).
+					Return(deliveryVariant, nil)
+				f.Pricing.On("QuotePrices", ctx, mock.Anything).
+					Return(priceQuote, nil)
+				// promo code is checked before reservations and applied again within transaction
+				f.PromotionsStorage.On("GetCampaignByCode", ctx, "SALE10").
+					Return(promoCampaign, nil)
+				f.Payments.On("CreatePaymentIntent", ctx, orderID.String()+"/update/3/347.250000000", mock.Anything, date.Add(15*time.Minute)).
+					Return(paymentIntent, nil).
+					Run(outOfTx(f))
+				f.WarehouseManagementSystem.On("ReserveStocks", ctx, models.UserID(1), []models.Item{
+					{SKU: models.SKU{ID: 2, Name: "Item 2"}, Quantity: 2, WarehouseID: 4},
+				}).
+					Return(nil).
+					Run(outOfTx(f))
+				f.OrdersStorage.On("GetOrderForUpdate", ctx, orderID).
+					Return(discounted(), nil)
+				f.PromotionsStorage.On("UpdateRedemptionDiscount", ctx, orderID, models.Money{CurrencyCode: "RUB", Units: 5, Nanos: 250_000_000}).
+					Return(nil).
+					Run(func(mock.Arguments) { f.redeemedInTx = f.inTx })
+				f.OrdersStorage.On("AppendOrderEvent", ctx, mock.Anything, updatedEvent(models.OrderFieldItems)).
+					Return(nil)
+				f.OrdersStorage.On("CreateOutboxMessage", ctx, mock.Anything, updatedEvent(models.OrderFieldItems)).
+					Return(nil)
+				f.AuditLog.On("Record", ctx, models.AuditActionUpdateOrder, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.PromotionsStorage.AssertNumberOfCalls(t, "GetCampaignByCode", 2)
+				assert.True(t, f.redeemedInTx, "redemption must be updated within transaction")
+				assert.False(t, f.calledInTx, "payment intent and stocks must be reserved before transaction")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fields{
+				WarehouseManagementSystem: mocks.NewWarehouseManagementSystem(t),
+				OrdersStorage:             mocks.NewOrdersStorage(t),
+				Catalog:                   mocks.NewCatalog(t),
+				DeliveryService:           mocks.NewDeliveryService(t),
+				Pricing:                   mocks.NewPricing(t),
+				Payments:                  mocks.NewPayments(t),
+				PromotionsStorage:         mocks.NewPromotionsStorage(t),
+				BusinessRules:             mocks.NewBusinessRules(t),
+				TransactionManager:        mocks.NewTransactionManager(t),
+				OrderEventBus:             mocks.NewOrderEventBus(t),
+				AuditLog:                  mocks.NewAuditLog(t),
+			}
+			f.TransactionManager.On("RunReadCommitted", mock.Anything, mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, _ pgx.TxAccessMode, fn func(context.Context) error) error {
+					f.inTx = true
+					defer func() { f.inTx = false }()
+					return fn(ctx)
+				}).
+				Maybe()
+			oms := &usecase{
+				Deps: Deps{
+					WarehouseManagementSystem: f.WarehouseManagementSystem,
+					OrdersStorage:             f.OrdersStorage,
+					Catalog:                   f.Catalog,
+					DeliveryService:           f.DeliveryService,
+					Pricing:                   f.Pricing,
+					Payments:                  f.Payments,
+					PromotionsStorage:         f.PromotionsStorage,
+					BusinessRules:             f.BusinessRules,
+					TransactionManager:        f.TransactionManager,
+					OrderEventBus:             f.OrderEventBus,
+					AuditLog:                  f.AuditLog,
+				},
+			}
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			got, err := oms.UpdateOrder(ctx, orderID, tt.info, tt.expectedVersion)
+			if tt.wantErr != nil {
+				var validationErr *models.ValidationError
+				if errors.As(tt.wantErr, &validationErr) {
+					assert.ErrorAs(t, err, &validationErr)
+				} else {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want(), got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_stockDeltas(t *testing.T) {
+	item := func(skuID models.SKUID, quantity uint32, warehouseID models.WarehouseID) models.Item {
+		return models.Item{SKU: models.SKU{ID: skuID}, Quantity: quantity, WarehouseID: warehouseID}
+	}
+
+	tests := []struct {
+		name        string
+		before      []models.Item
+		after       []models.Item
+		wantReserve []models.Item
+		wantRelease []models.Item
+	}{
+		{
+			name:   "Test 1. Positive. Same items.",
+			before: []models.Item{item(1, 2, 1)},
+			after:  []models.Item{item(1, 2, 1)},
+		},
+		{
+			name:        "Test 2. Positive. Quantities are changed.",
+			before:      []models.Item{item(1, 2, 1), item(2, 5, 1)},
+			after:       []models.Item{item(1, 3, 1), item(2, 1, 1)},
+			wantReserve: []models.Item{item(1, 1, 1)},
+			wantRelease: []models.Item{item(2, 4, 1)},
+		},
+		{
+			name:        "Test 3. Positive. SKU is moved to another warehouse.",
+			before:      []models.Item{item(1, 2, 1)},
+			after:       []models.Item{item(1, 2, 2)},
+			wantReserve: []models.Item{item(1, 2, 2)},
+			wantRelease: []models.Item{item(1, 2, 1)},
+		},
+		{
+			name:        "Test 4. Positive. Repeated SKU is summed up.",
+			before:      []models.Item{item(1, 2, 1), item(1, 2, 1)},
+			after:       []models.Item{item(1, 5, 1)},
+			wantReserve: []models.Item{item(1, 1, 1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reserve, release := stockDeltas(tt.before, tt.after)
+			assert.Equal(t, tt.wantReserve, reserve)
+			assert.Equal(t, tt.wantRelease, release)
+		})
+	}
+}
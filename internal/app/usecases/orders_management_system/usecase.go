@@ -3,6 +3,7 @@ package orders_management_system
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
@@ -14,38 +15,122 @@ var (
 
 type UsecaseInterface interface {
 	CreateOrder(ctx context.Context, userID models.UserID, info CreateOrderInfo) (*models.Order, error)
+	UpdateOrder(ctx context.Context, orderID models.OrderID, info UpdateOrderInfo, expectedVersion int64) (*models.Order, error)
+	ConfirmPayment(ctx context.Context, orderID models.OrderID, paymentID models.PaymentID, expectedVersion int64) (*models.Order, error)
+	CancelExpiredOrders(ctx context.Context) (int, error)
+	WatchOrder(ctx context.Context, orderID models.OrderID, fn func(models.OrderEvent) error) error
+	GetOrder(ctx context.Context, orderID models.OrderID) (*models.Order, error)
+	StreamOrderEvents(ctx context.Context, orderID models.OrderID, lastEventID int64, fn func(models.OrderEvent) error) error
 }
 
 //go:generate mockery --name=WarehouseManagementSystem --filename=warehouse_management_system_mock.go --disable-version-string
 //go:generate mockery --name=OrdersStorage --filename=orders_storage_mock.go --disable-version-string
+//go:generate mockery --name=BusinessRules --filename=business_rules_mock.go --disable-version-string
+//go:generate mockery --name=Catalog --filename=catalog_mock.go --disable-version-string
+//go:generate mockery --name=DeliveryService --filename=delivery_service_mock.go --disable-version-string
+//go:generate mockery --name=Payments --filename=payments_mock.go --disable-version-string
+//go:generate mockery --name=Pricing --filename=pricing_mock.go --disable-version-string
+//go:generate mockery --name=PromotionsStorage --filename=promotions_storage_mock.go --disable-version-string
+//go:generate mockery --name=TransactionManager --filename=transaction_manager_mock.go --disable-version-string
+//go:generate mockery --name=OrderEventBus --filename=order_event_bus_mock.go --disable-version-string
+//go:generate mockery --name=AuditLog --filename=audit_log_mock.go --disable-version-string
 
 type (
 	WarehouseManagementSystem interface {
 		ReserveStocks(ctx context.Context, userID models.UserID, items []models.Item) error
+		// ReleaseStocks - returns reserved stocks, repeated calls with the same key release stocks once
+		ReleaseStocks(ctx context.Context, key string, userID models.UserID, items []models.Item) error
 	}
 
 	OrdersStorage interface {
-		CreateOrder(ctx context.Context, order *models.Order) error
-		CreateOutboxMessage(ctx context.Context, order *models.Order) error
+		// AppendOrderEvent - appends event to order stream and writes order, the state after event, to orders projection
+		AppendOrderEvent(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error
+		GetOrder(ctx context.Context, id models.OrderID) (*models.Order, error)
+		GetOrderForUpdate(ctx context.Context, id models.OrderID) (*models.Order, error)
+		ListExpiredOrders(ctx context.Context, now time.Time, limit uint64) ([]models.OrderID, error)
+		CreateOutboxMessage(ctx context.Context, order *models.Order, event *models.OrderDomainEvent) error
+		CreateStatusHistory(ctx context.Context, event *models.OrderEvent) error
+		ListStatusHistory(ctx context.Context, orderID models.OrderID, afterID int64) ([]models.OrderEvent, error)
+	}
+
+	PromotionsStorage interface {
+		GetCampaignByCode(ctx context.Context, code string) (*models.PromoCampaign, error)
+		LockRedemptions(ctx context.Context, campaignID models.PromoCampaignID, userID models.UserID) error
+		CountRedemptions(ctx context.Context, campaignID models.PromoCampaignID, userID models.UserID) (uint32, error)
+		CreateRedemption(ctx context.Context, redemption *models.PromoRedemption) error
+		UpdateRedemptionDiscount(ctx context.Context, orderID models.OrderID, discount models.Money) error
+	}
+
+	Catalog interface {
+		GetSKUs(ctx context.Context, ids []models.SKUID) ([]models.CatalogSKU, error)
+	}
+
+	DeliveryService interface {
+		GetDeliveryVariant(ctx context.Context, id models.DeliveryVariantID) (*models.DeliveryVariant, error)
+		ReserveDeliverySlot(ctx context.Context, userID models.UserID, variantID models.DeliveryVariantID, slotID models.DeliverySlotID) error
+		// ReleaseDeliverySlot - cancels reservation of delivery slot, repeated calls with the same key release slot once
+		ReleaseDeliverySlot(ctx context.Context, key string, userID models.UserID, variantID models.DeliveryVariantID, slotID models.DeliverySlotID) error
+	}
+
+	Pricing interface {
+		QuotePrices(ctx context.Context, order *models.Order) (*models.PriceQuote, error)
+	}
+
+	Payments interface {
+		// CreatePaymentIntent - creates payment intent for order total, repeated calls with the same key return the same intent
+		CreatePaymentIntent(ctx context.Context, key string, order *models.Order, expiresAt time.Time) (*models.PaymentIntent, error)
+	}
+
+	BusinessRules interface {
+		ValidateOrder(ctx context.Context, order *models.Order) error
 	}
 
 	TransactionManager interface {
 		RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
 	}
+
+	OrderEventBus interface {
+		// Publish - delivers event to watchers after commit of transaction in ctx
+		Publish(ctx context.Context, event models.OrderEvent)
+		// Subscribe - returns channel of order events, it is closed when watcher falls behind
+		Subscribe(orderID models.OrderID) (<-chan models.OrderEvent, func())
+	}
+
+	AuditLog interface {
+		// Record - saves record of order mutation in transaction of ctx, before is nil for created order
+		Record(ctx context.Context, action models.AuditAction, before, after *models.Order) error
+	}
 )
 
 type Deps struct {
 	TransactionManager
 	WarehouseManagementSystem
+	Catalog
+	DeliveryService
+	Pricing
+	Payments
 	OrdersStorage
+	PromotionsStorage
+	BusinessRules
+	OrderEventBus
+	AuditLog
+}
+
+type Config struct {
+	// PaymentTTL - time given to pay the order, unpaid order is cancelled after it
+	PaymentTTL time.Duration
+	// ExpiredOrdersBatchSize - max number of unpaid orders cancelled per CancelExpiredOrders call
+	ExpiredOrdersBatchSize uint64
 }
 
 type usecase struct {
 	Deps
+	cfg Config
 }
 
-func NewUsecase(d Deps) UsecaseInterface {
+func NewUsecase(cfg Config, d Deps) UsecaseInterface {
 	return &usecase{
 		Deps: d,
+		cfg:  cfg,
 	}
 }
@@ -0,0 +1,47 @@
+package orders_management_system
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	pkgerrors "github.com/moguchev/microservices_courcse/orders_management_system/pkg/errors"
+)
+
+// WatchOrder - calls fn with current order state and then with every status transition until ctx is done
+func (oms *usecase) WatchOrder(ctx context.Context, orderID models.OrderID, fn func(models.OrderEvent) error) error {
+	const api = "orders_management_system.usecase.WatchOrder"
+
+	// subscribe before reading the order so that no transition is missed in between
+	events, unsubscribe := oms.OrderEventBus.Subscribe(orderID)
+	defer unsubscribe()
+
+	order, err := oms.OrdersStorage.GetOrder(ctx, orderID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	last := order.Status
+	if err = fn(models.OrderEvent{OrderID: order.ID, Status: order.Status}); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return pkgerrors.Wrap(api, models.ErrWatchLagged)
+			}
+			// transition committed before the order was read
+			if event.Status == last {
+				continue
+			}
+			last = event.Status
+
+			if err = fn(event); err != nil {
+				return pkgerrors.Wrap(api, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//go:build test
+
+package orders_management_system
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/usecases/orders_management_system/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_usecase_WatchOrder(t *testing.T) {
+	var (
+		orderID   = models.OrderID(uuid.New())
+		errStream = errors.New("stream is broken")
+		paidAt    = time.Now()
+	)
+
+	type fields struct {
+		OrdersStorage *mocks.OrdersStorage
+		OrderEventBus *mocks.OrderEventBus
+	}
+
+	tests := []struct {
+		name string
+		// events - published to subscriber, channel is closed after them when closeEvents is set
+		events      []models.OrderEvent
+		closeEvents bool
+		sendErr     error
+		want        []models.OrderEvent
+		wantErr     error
+
+		on func(*fields)
+	}{
+		{
+			name: "Test 1. Positive. Snapshot and transitions, duplicate of snapshot is skipped.",
+			events: []models.OrderEvent{
+				{OrderID: orderID, Type: models.OrderEventCreated, Status: models.OrderStatusAwaitingPayment},
+				{OrderID: orderID, Type: models.OrderEventPaid, Status: models.OrderStatusPaid, OccurredAt: paidAt},
+			},
+			want: []models.OrderEvent{
+				{OrderID: orderID, Status: models.OrderStatusAwaitingPayment},
+				{OrderID: orderID, Type: models.OrderEventPaid, Status: models.OrderStatusPaid, OccurredAt: paidAt},
+			},
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", mock.Anything, orderID).
+					Return(&models.Order{ID: orderID, Status: models.OrderStatusAwaitingPayment}, nil)
+			},
+		},
+		{
+			name:        "Test 2. Negative. Watcher fell behind.",
+			closeEvents: true,
+			want: []models.OrderEvent{
+				{OrderID: orderID, Status: models.OrderStatusPaid},
+			},
+			wantErr: models.ErrWatchLagged,
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", mock.Anything, orderID).
+					Return(&models.Order{ID: orderID, Status: models.OrderStatusPaid}, nil)
+			},
+		},
+		{
+			name:    "Test 3. Negative. Order not found.",
+			wantErr: models.ErrNotFound,
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", mock.Anything, orderID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name:    "Test 4. Negative. Send failed.",
+			sendErr: errStream,
+			wantErr: errStream,
+			on: func(f *fields) {
+				f.OrdersStorage.On("GetOrder", mock.Anything, orderID).
+					Return(&models.Order{ID: orderID, Status: models.OrderStatusPaid}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f := &fields{
+				OrdersStorage: mocks.NewOrdersStorage(t),
+				OrderEventBus: mocks.NewOrderEventBus(t),
+			}
+
+			events := make(chan models.OrderEvent, len(tt.events))
+			for _, event := range tt.events {
+				events <- event
+			}
+			if tt.closeEvents {
+				close(events)
+			}
+
+			unsubscribed := false
+			f.OrderEventBus.On("Subscribe", orderID).
+				Return((<-chan models.OrderEvent)(events), func() { unsubscribed = true })
+
+			oms := &usecase{
+				Deps: Deps{
+					OrdersStorage: f.OrdersStorage,
+					OrderEventBus: f.OrderEventBus,
+				},
+			}
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			var got []models.OrderEvent
+			err := oms.WatchOrder(ctx, orderID, func(event models.OrderEvent) error {
+				if tt.sendErr != nil {
+					return tt.sendErr
+				}
+				got = append(got, event)
+				if len(got) == len(tt.want) && !tt.closeEvents {
+					cancel() // client went away
+				}
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.True(t, unsubscribed)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package audit - append-only history of order mutations: who changed which fields of the order and from where.
+// Records are saved in transaction of the mutation and written to audit log sink after commit
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+type (
+	// Storage - audit_log table, it rejects updates and deletes
+	Storage interface {
+		// CreateAuditRecord - saves record, sets its ID
+		CreateAuditRecord(ctx context.Context, record *models.AuditRecord) error
+		// ListAuditRecords - returns records of order with id greater than afterID in order they were made
+		ListAuditRecords(ctx context.Context, orderID models.OrderID, afterID int64, limit uint64) ([]models.AuditRecord, error)
+	}
+
+	// Committer - defers function till commit of transaction in ctx
+	Committer interface {
+		AfterCommit(ctx context.Context, fn func())
+	}
+)
+
+// Log - audit log of order mutations
+type Log struct {
+	storage   Storage
+	committer Committer
+}
+
+// New - returns audit log
+func New(storage Storage, committer Committer) *Log {
+	return &Log{
+		storage:   storage,
+		committer: committer,
+	}
+}
+
+// Record - saves record of order mutation in transaction of ctx, before is nil for created order.
+// Actor, client IP and request id are taken from ctx
+func (l *Log) Record(ctx context.Context, action models.AuditAction, before, after *models.Order) error {
+	record := &models.AuditRecord{
+		OrderID:   after.ID,
+		Actor:     Actor(ctx),
+		Action:    action,
+		Changes:   Diff(before, after),
+		ClientIP:  ClientIP(ctx),
+		RequestID: logger.RequestID(ctx),
+		CreatedAt: time.Now(),
+	}
+
+	if err := l.storage.CreateAuditRecord(ctx, record); err != nil {
+		return err
+	}
+
+	// mutation might be rolled back, so only committed records get to the sink
+	l.committer.AfterCommit(ctx, func() {
+		logger.Audit(ctx, "order mutation",
+			"audit_id", record.ID,
+			"order_id", record.OrderID.String(),
+			"actor", record.Actor,
+			"action", string(record.Action),
+			"changes", record.Changes,
+			"client_ip", record.ClientIP,
+		)
+	})
+
+	return nil
+}
+
+// List - returns records of order with id greater than afterID in order they were made
+func (l *Log) List(ctx context.Context, orderID models.OrderID, afterID int64, limit uint64) ([]models.AuditRecord, error) {
+	return l.storage.ListAuditRecords(ctx, orderID, afterID, limit)
+}
@@ -0,0 +1,257 @@
+//go:build test
+
+package audit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/redact"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type fakeStorage struct {
+	records []models.AuditRecord
+}
+
+func (s *fakeStorage) CreateAuditRecord(_ context.Context, record *models.AuditRecord) error {
+	record.ID = int64(len(s.records) + 1)
+	s.records = append(s.records, *record)
+	return nil
+}
+
+func (s *fakeStorage) ListAuditRecords(context.Context, models.OrderID, int64, uint64) ([]models.AuditRecord, error) {
+	return s.records, nil
+}
+
+// fakeCommitter - collects hooks until Commit is called
+type fakeCommitter struct {
+	hooks []func()
+}
+
+func (c *fakeCommitter) AfterCommit(_ context.Context, fn func()) {
+	c.hooks = append(c.hooks, fn)
+}
+
+func TestDiff(t *testing.T) {
+	order := &models.Order{
+		ID:     models.OrderID(uuid.New()),
+		UserID: 42,
+		Status: models.OrderStatusAwaitingPayment,
+		Items: []models.Item{
+			{SKU: models.SKU{ID: 1}, Quantity: 2, WarehouseID: 3},
+		},
+		PaymentOrderInfo: models.PaymentOrderInfo{
+			PaymentID:  "pi_1",
+			PaymentURL: "https://pay.example.com/pi_1",
+		},
+	}
+	paid := *order
+	paid.Status = models.OrderStatusPaid
+
+	tests := []struct {
+		name   string
+		before *models.Order
+		after  *models.Order
+		want   []models.AuditChange
+	}{
+		{
+			name:   "Test 1. Created order, sensitive values are masked.",
+			before: nil,
+			after:  order,
+			want: []models.AuditChange{
+				{Field: "user_id", After: "42"},
+				{Field: "status", After: "awaiting_payment"},
+				{Field: "items", After: `[{"sku_id":1,"quantity":2,"warehouse_id":3}]`},
+				{Field: "payment_id", After: "pi_1"},
+				{Field: "payment_url", After: redact.Mask},
+			},
+		},
+		{
+			name:   "Test 2. Only changed fields.",
+			before: order,
+			after:  &paid,
+			want: []models.AuditChange{
+				{Field: "status", Before: "awaiting_payment", After: "paid"},
+			},
+		},
+		{
+			name:   "Test 3. Nothing changed.",
+			before: order,
+			after:  order,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Diff(tt.before, tt.after))
+		})
+	}
+}
+
+func TestActor(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "Test 1. No actor.",
+			ctx:  context.Background(),
+			want: ActorAnonymous,
+		},
+		{
+			name: "Test 2. Actor of ctx.",
+			ctx:  WithActor(context.Background(), "user:42"),
+			want: "user:42",
+		},
+		{
+			name: "Test 3. Actor of metadata is ignored.",
+			ctx: metadata.NewIncomingContext(WithActor(context.Background(), "user:42"),
+				metadata.Pairs("x-actor", "support:7")),
+			want: "user:42",
+		},
+		{
+			name: "Test 4. Actor of metadata is not trusted without actor of ctx.",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-actor", "support:7")),
+			want: ActorAnonymous,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Actor(tt.ctx))
+		})
+	}
+}
+
+func TestActorUnaryInterceptor(t *testing.T) {
+	gatewayCall := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "198.51.100.2"))
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  interface{}
+		want string
+	}{
+		{
+			name: "Test 1. Request on behalf of user.",
+			ctx:  gatewayCall,
+			req:  &pb.CreateOrderRequest{UserId: 42},
+			want: "user:42",
+		},
+		{
+			name: "Test 2. Request without user is made by client.",
+			ctx:  gatewayCall,
+			req:  &pb.UpdateOrderRequest{OrderId: uuid.NewString()},
+			want: "client:198.51.100.2",
+		},
+		{
+			name: "Test 3. Actor set by outer interceptor is kept.",
+			ctx:  WithActor(gatewayCall, ActorAdmin),
+			req:  &pb.ConfirmPaymentRequest{OrderId: uuid.NewString()},
+			want: ActorAdmin,
+		},
+		{
+			name: "Test 4. Unknown caller.",
+			ctx:  context.Background(),
+			req:  &pb.ConfirmPaymentRequest{OrderId: uuid.NewString()},
+			want: ActorAnonymous,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			_, err := ActorUnaryInterceptor()(tt.ctx, tt.req, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					got = Actor(ctx)
+					return nil, nil
+				})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "Test 1. Not a call.",
+			ctx:  context.Background(),
+			want: "",
+		},
+		{
+			name: "Test 2. Peer address.",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051},
+			}),
+			want: "10.0.0.1",
+		},
+		{
+			name: "Test 3. Forwarded address of gRPC caller is ignored.",
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), &peer.Peer{
+					Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051},
+				}),
+				metadata.Pairs("x-forwarded-for", "203.0.113.7"),
+			),
+			want: "10.0.0.1",
+		},
+		{
+			name: "Test 4. Gateway call. Address appended by gateway is taken, forged one is ignored.",
+			ctx: metadata.NewIncomingContext(
+				context.Background(),
+				metadata.Pairs("x-forwarded-for", "203.0.113.7, 198.51.100.2"),
+			),
+			want: "198.51.100.2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClientIP(tt.ctx))
+		})
+	}
+}
+
+func TestLog_Record(t *testing.T) {
+	var (
+		storage   = &fakeStorage{}
+		committer = &fakeCommitter{}
+		log       = New(storage, committer)
+	)
+
+	ctx := logger.WithRequestID(WithActor(context.Background(), ActorSystem), "req-1")
+	before := &models.Order{ID: models.OrderID(uuid.New()), Status: models.OrderStatusAwaitingPayment}
+	after := *before
+	after.Status = models.OrderStatusCancelled
+
+	require.NoError(t, log.Record(ctx, models.AuditActionCancelExpiredOrder, before, &after))
+
+	require.Len(t, storage.records, 1)
+	record := storage.records[0]
+	assert.Equal(t, int64(1), record.ID)
+	assert.Equal(t, before.ID, record.OrderID)
+	assert.Equal(t, ActorSystem, record.Actor)
+	assert.Equal(t, models.AuditActionCancelExpiredOrder, record.Action)
+	assert.Equal(t, "req-1", record.RequestID)
+	assert.Empty(t, record.ClientIP)
+	assert.WithinDuration(t, time.Now(), record.CreatedAt, time.Minute)
+	assert.Equal(t, []models.AuditChange{
+		{Field: "status", Before: "awaiting_payment", After: "cancelled"},
+	}, record.Changes)
+
+	assert.Len(t, committer.hooks, 1, "record is written to sink after commit")
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// forwardedForHeader - client addresses, grpc-gateway appends address of REST caller to the ones sent by it
+	forwardedForHeader = "x-forwarded-for"
+
+	// ActorAnonymous - caller without identity
+	ActorAnonymous = "anonymous"
+	// ActorSystem - background jobs of the service
+	ActorSystem = "system"
+	// ActorAdmin - caller of admin methods authenticated by admin token
+	ActorAdmin = "admin"
+)
+
+type actorContextKey struct{}
+
+// WithActor - returns ctx whose mutations are recorded on behalf of actor, e.g. "user:42" or "system"
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// Actor - returns actor of ctx set by the server or ActorAnonymous.
+// Identity passed by caller in metadata is not trusted as it can be set by any client
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorAnonymous
+}
+
+// ClientIP - returns address of the caller: peer address of gRPC call or, for call of in-process gateway
+// which has no peer, rightmost x-forwarded-for address appended by the gateway. Addresses sent by caller
+// are not trusted as they can be forged. Empty when ctx is not a call
+func ClientIP(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			return host
+		}
+		return addr
+	}
+
+	if forwarded := firstIncoming(ctx, forwardedForHeader); forwarded != "" {
+		return strings.TrimSpace(forwarded[strings.LastIndex(forwarded, ",")+1:])
+	}
+
+	return ""
+}
+
+func firstIncoming(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
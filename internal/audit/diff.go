@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/redact"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+)
+
+// Diff - returns changed fields of the order, before is nil for created order.
+// Values of fields marked with (sensitive) option in API, e.g. payment url, are masked
+func Diff(before, after *models.Order) []models.AuditChange {
+	was, is := snapshot(before), snapshot(after)
+
+	var changes []models.AuditChange
+	for i := range is {
+		if was[i].value == is[i].value {
+			continue
+		}
+
+		change := models.AuditChange{
+			Field:  is[i].name,
+			Before: was[i].value,
+			After:  is[i].value,
+		}
+		if is[i].sensitive {
+			change.Before, change.After = mask(change.Before), mask(change.After)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// paymentFields - fields of the payment in API, audited payment fields are masked as they are
+var paymentFields = (&pb.CreateOrderResponse_Payment{}).ProtoReflect().Descriptor().Fields()
+
+var (
+	sensitivePaymentID        = redact.IsSensitive(paymentFields.ByName("payment_id"))
+	sensitivePaymentURL       = redact.IsSensitive(paymentFields.ByName("confirmation_url"))
+	sensitivePaymentExpiresAt = redact.IsSensitive(paymentFields.ByName("expires_at"))
+)
+
+type fieldValue struct {
+	name      string
+	value     string
+	sensitive bool
+}
+
+// snapshot - audited fields of the order in fixed order, zero values are empty
+func snapshot(order *models.Order) []fieldValue {
+	if order == nil {
+		order = &models.Order{}
+	}
+
+	return []fieldValue{
+		{name: "user_id", value: formatID(uint64(order.UserID))},
+		{name: "status", value: string(order.Status)},
+		{name: "items", value: formatItems(order.Items)},
+		{name: "delivery_variant_id", value: formatID(uint64(order.DeliveryVariantID))},
+		{name: "delivery_date", value: formatTime(order.DeliveryDate)},
+		{name: "delivery_slot_id", value: formatID(uint64(order.DeliverySlotID))},
+		{name: "currency_code", value: order.CurrencyCode},
+		{name: "subtotal", value: formatMoney(order.Subtotal)},
+		{name: "delivery_cost", value: formatMoney(order.DeliveryCost)},
+		{name: "discount", value: formatMoney(order.Discount)},
+		{name: "promo_code", value: order.PromoCode},
+		{name: "total", value: formatMoney(order.Total)},
+		{name: "payment_id", value: string(order.PaymentID), sensitive: sensitivePaymentID},
+		{name: "payment_url", value: order.PaymentURL, sensitive: sensitivePaymentURL},
+		{name: "payment_expires_at", value: formatTime(order.PaymentExpiresAt), sensitive: sensitivePaymentExpiresAt},
+	}
+}
+
+func mask(value string) string {
+	if value == "" {
+		return ""
+	}
+	return redact.Mask
+}
+
+func formatID(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatUint(id, 10)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatMoney(m models.Money) string {
+	if m.IsZero() {
+		return ""
+	}
+	return m.String()
+}
+
+type auditItem struct {
+	SKUID       uint64 `json:"sku_id"`
+	Quantity    uint32 `json:"quantity"`
+	WarehouseID uint64 `json:"warehouse_id"`
+	UnitPrice   string `json:"unit_price,omitempty"`
+}
+
+// formatItems - items as JSON array, so that changes of any item are seen as change of items
+func formatItems(items []models.Item) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	res := make([]auditItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, auditItem{
+			SKUID:       uint64(item.SKU.ID),
+			Quantity:    item.Quantity,
+			WarehouseID: uint64(item.WarehouseID),
+			UnitPrice:   formatMoney(item.UnitPrice),
+		})
+	}
+
+	b, _ := json.Marshal(res) // marshalling of plain struct never fails
+	return string(b)
+}
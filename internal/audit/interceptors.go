@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+)
+
+// userIDGetter - request made on behalf of user
+type userIDGetter interface {
+	GetUserId() uint64
+}
+
+// ActorUnaryInterceptor - sets actor of the call unless it is already set by outer interceptor:
+// "user:<id>" for request made on behalf of user, otherwise "client:<address>" of the caller
+func ActorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if Actor(ctx) == ActorAnonymous {
+			ctx = WithActor(ctx, callActor(ctx, req))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// callActor - ActorAnonymous when caller is not known
+func callActor(ctx context.Context, req interface{}) string {
+	if r, ok := req.(userIDGetter); ok && r.GetUserId() != 0 {
+		return "user:" + strconv.FormatUint(r.GetUserId(), 10)
+	}
+	if ip := ClientIP(ctx); ip != "" {
+		return "client:" + ip
+	}
+	return ActorAnonymous
+}
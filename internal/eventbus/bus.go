@@ -0,0 +1,119 @@
+// Package eventbus - in-process fan-out of events to subscribers of a key, events are published after commit
+package eventbus
+
+import (
+	"context"
+	"sync"
+)
+
+// Committer - defers function till commit of transaction in ctx
+type Committer interface {
+	AfterCommit(ctx context.Context, fn func())
+}
+
+// Bus - delivers events to subscribers of event key, slow subscribers are dropped:
+// their channel is closed and they have to resubscribe
+type Bus[K comparable, E any] struct {
+	committer Committer
+	key       func(E) K
+	buffer    int
+
+	mu          sync.RWMutex
+	subscribers map[K]map[*subscriber[E]]struct{}
+}
+
+type subscriber[E any] struct {
+	ch   chan E
+	once sync.Once
+}
+
+func (s *subscriber[E]) close() {
+	s.once.Do(func() { close(s.ch) })
+}
+
+// Option - bus option
+type Option func(o *options)
+
+type options struct {
+	buffer int
+}
+
+// WithBuffer - number of events buffered per subscriber before it is dropped
+func WithBuffer(n int) Option {
+	return func(o *options) {
+		o.buffer = n
+	}
+}
+
+// New - returns bus, key returns key of event subscribers are interested in
+func New[K comparable, E any](committer Committer, key func(E) K, opts ...Option) *Bus[K, E] {
+	o := options{buffer: 16}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Bus[K, E]{
+		committer:   committer,
+		key:         key,
+		buffer:      o.buffer,
+		subscribers: make(map[K]map[*subscriber[E]]struct{}),
+	}
+}
+
+// Publish - delivers event to subscribers after commit of transaction in ctx, never blocks
+func (b *Bus[K, E]) Publish(ctx context.Context, event E) {
+	b.committer.AfterCommit(ctx, func() {
+		b.dispatch(event)
+	})
+}
+
+// Subscribe - returns channel of events with the key, unsubscribe must be called when events are not needed.
+// Channel is closed when subscriber falls behind
+func (b *Bus[K, E]) Subscribe(key K) (<-chan E, func()) {
+	sub := &subscriber[E]{ch: make(chan E, b.buffer)}
+
+	b.mu.Lock()
+	if b.subscribers[key] == nil {
+		b.subscribers[key] = make(map[*subscriber[E]]struct{})
+	}
+	b.subscribers[key][sub] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.remove(key, sub)
+		sub.close()
+	}
+
+	return sub.ch, unsubscribe
+}
+
+func (b *Bus[K, E]) dispatch(event E) {
+	key := b.key(event)
+
+	var lagging []*subscriber[E]
+
+	b.mu.RLock()
+	for sub := range b.subscribers[key] {
+		select {
+		case sub.ch <- event:
+		default:
+			lagging = append(lagging, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range lagging {
+		b.remove(key, sub)
+		sub.close()
+	}
+}
+
+func (b *Bus[K, E]) remove(key K, sub *subscriber[E]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers[key], sub)
+	if len(b.subscribers[key]) == 0 {
+		delete(b.subscribers, key)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build test
+
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type event struct {
+	key   string
+	value int
+}
+
+// fakeCommitter - collects hooks until Commit is called
+type fakeCommitter struct {
+	hooks []func()
+}
+
+func (c *fakeCommitter) AfterCommit(_ context.Context, fn func()) {
+	c.hooks = append(c.hooks, fn)
+}
+
+func (c *fakeCommitter) Commit() {
+	for _, fn := range c.hooks {
+		fn()
+	}
+	c.hooks = nil
+}
+
+func newBus(committer Committer, opts ...Option) *Bus[string, event] {
+	return New(committer, func(e event) string { return e.key }, opts...)
+}
+
+func TestBus_Publish(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		committer = &fakeCommitter{}
+		bus       = newBus(committer)
+	)
+
+	first, unsubscribeFirst := bus.Subscribe("a")
+	second, unsubscribeSecond := bus.Subscribe("a")
+	other, unsubscribeOther := bus.Subscribe("b")
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	bus.Publish(ctx, event{key: "a", value: 1})
+	assert.Empty(t, first, "event is delivered before commit")
+
+	committer.Commit()
+	assert.Equal(t, event{key: "a", value: 1}, <-first)
+	assert.Equal(t, event{key: "a", value: 1}, <-second)
+	assert.Empty(t, other)
+
+	unsubscribeFirst()
+	_, ok := <-first
+	assert.False(t, ok, "channel is closed on unsubscribe")
+
+	bus.Publish(ctx, event{key: "a", value: 2})
+	committer.Commit()
+	assert.Equal(t, event{key: "a", value: 2}, <-second)
+}
+
+func TestBus_Publish_laggingSubscriber(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		committer = &fakeCommitter{}
+		bus       = newBus(committer, WithBuffer(1))
+	)
+
+	events, unsubscribe := bus.Subscribe("a")
+	defer unsubscribe() // safe after the bus dropped subscriber
+
+	bus.Publish(ctx, event{key: "a", value: 1})
+	bus.Publish(ctx, event{key: "a", value: 2})
+	committer.Commit()
+
+	got, ok := <-events
+	require.True(t, ok)
+	assert.Equal(t, 1, got.value)
+
+	_, ok = <-events
+	assert.False(t, ok, "lagging subscriber is dropped")
+	assert.Empty(t, bus.subscribers)
+}
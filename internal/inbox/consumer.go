@@ -0,0 +1,259 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	// Storage - inbox tables
+	Storage interface {
+		// SaveMessage - remembers message as processed, returns false when it was processed before
+		SaveMessage(ctx context.Context, msg Message) (bool, error)
+		// SaveDeadLetter - saves message which could not be processed
+		SaveDeadLetter(ctx context.Context, msg Message, attempts int, lastErr error) error
+	}
+
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+	}
+)
+
+// Consumer - fetches messages from subscriber and handles them exactly once
+type Consumer struct {
+	subscriber Subscriber
+	storage    Storage
+	txManager  TransactionManager
+
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	handlers map[string]Handler
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Option - consumer option
+type Option func(c *Consumer)
+
+// WithMaxAttempts - number of handling attempts before message goes to dead letters
+func WithMaxAttempts(n int) Option {
+	return func(c *Consumer) {
+		c.maxAttempts = n
+	}
+}
+
+// WithBackoff - exponential backoff between attempts
+func WithBackoff(initial, max time.Duration) Option {
+	return func(c *Consumer) {
+		c.initialBackoff = initial
+		c.maxBackoff = max
+	}
+}
+
+// NewConsumer - returns consumer
+func NewConsumer(subscriber Subscriber, storage Storage, txManager TransactionManager, opts ...Option) *Consumer {
+	c := &Consumer{
+		subscriber:     subscriber,
+		storage:        storage,
+		txManager:      txManager,
+		maxAttempts:    5,
+		initialBackoff: 100 * time.Millisecond,
+		maxBackoff:     10 * time.Second,
+		handlers:       make(map[string]Handler),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Handle - registers handler of topic, must be called before Start
+func (c *Consumer) Handle(topic string, h Handler) {
+	c.handlers[topic] = h
+}
+
+// Start - starts consumer in background, it is stopped by closer
+func (c *Consumer) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+
+	closer.Add(c.Stop)
+
+	go func() {
+		defer close(c.done)
+		if err := c.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.ErrorKV(ctx, "inbox: consumer stopped", "error", err.Error())
+		}
+	}()
+}
+
+// Stop - stops consumer, waits for message in progress and closes subscriber
+func (c *Consumer) Stop(ctx context.Context) error {
+	if c.cancel == nil {
+		return c.subscriber.Close()
+	}
+	c.cancel()
+
+	select {
+	case <-c.done:
+		return c.subscriber.Close()
+	case <-ctx.Done():
+		return fmt.Errorf("inbox: stop: %w", ctx.Err())
+	}
+}
+
+// Run - consumes messages until ctx is done or subscriber is closed
+func (c *Consumer) Run(ctx context.Context) error {
+	for {
+		msg, err := c.subscriber.Fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, ErrSubscriberClosed) {
+				return err
+			}
+			logger.ErrorKV(ctx, "inbox: fetch", "error", err.Error())
+			continue
+		}
+
+		msgCtx := logger.WithFields(ctx,
+			zap.String("message_id", msg.ID),
+			zap.String("topic", msg.Topic),
+		)
+
+		if err = c.consume(msgCtx, msg); err != nil {
+			return err // ctx is done, message will be redelivered
+		}
+	}
+}
+
+// consume - processes and commits message in span continuing the trace of producer
+func (c *Consumer) consume(ctx context.Context, msg Message) error {
+	ctx, span := tracing.Start(tracing.ContextWithTraceContext(ctx, msg.Headers["traceparent"], msg.Headers["tracestate"]),
+		"inbox.Consume "+msg.Topic,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("inbox.message_id", msg.ID),
+			attribute.String("inbox.topic", msg.Topic),
+		),
+	)
+	defer span.End()
+
+	if err := c.process(ctx, msg); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	if err := c.subscriber.Commit(ctx, msg); err != nil {
+		logger.ErrorKV(ctx, "inbox: commit", "error", err.Error())
+	}
+
+	return nil
+}
+
+// process - handles message with retries, exhausted message is saved to dead letters
+func (c *Consumer) process(ctx context.Context, msg Message) error {
+	backoff := c.initialBackoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = c.handle(ctx, msg); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if IsPermanent(err) || attempt >= c.maxAttempts {
+			logger.ErrorKV(ctx, "inbox: message is dead-lettered", "error", err.Error(), "attempts", attempt)
+			return c.deadLetter(ctx, msg, attempt, err)
+		}
+
+		logger.WarnKV(ctx, "inbox: handle failed, retrying", "error", err.Error(), "attempt", attempt, "backoff", backoff.String())
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+// handle - saves message to inbox and runs handler in the same transaction,
+// duplicate is skipped without running handler
+func (c *Consumer) handle(ctx context.Context, msg Message) (err error) {
+	handler, ok := c.handlers[msg.Topic]
+	if !ok {
+		return Permanent(fmt.Errorf("no handler for topic %q", msg.Topic))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic recovered: %v", r)
+		}
+	}()
+
+	return c.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			saved, err := c.storage.SaveMessage(txCtx, msg)
+			if err != nil {
+				return err
+			}
+			if !saved {
+				logger.Info(txCtx, "inbox: duplicate message skipped")
+				return nil
+			}
+
+			return handler(txCtx, msg)
+		},
+	)
+}
+
+func (c *Consumer) deadLetter(ctx context.Context, msg Message, attempts int, lastErr error) error {
+	backoff := c.initialBackoff
+	for {
+		// message is remembered as processed too, so redelivered copies don't reach handler again
+		err := c.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+			func(txCtx context.Context) error {
+				saved, err := c.storage.SaveMessage(txCtx, msg)
+				if err != nil || !saved {
+					return err
+				}
+				return c.storage.SaveDeadLetter(txCtx, msg, attempts, lastErr)
+			},
+		)
+		if err == nil {
+			return nil
+		}
+		logger.ErrorKV(ctx, "inbox: save dead letter", "error", err.Error())
+
+		// message must not be lost: keep trying until storage is back or shutdown
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+func (c *Consumer) nextBackoff(backoff time.Duration) time.Duration {
+	if backoff *= 2; backoff > c.maxBackoff {
+		return c.maxBackoff
+	}
+	return backoff
+}
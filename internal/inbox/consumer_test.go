@@ -0,0 +1,175 @@
+//go:build test
+
+package inbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type deadLetter struct {
+	msg      Message
+	attempts int
+	lastErr  string
+}
+
+// fakeStorage - storage with transactional semantics: writes are visible after commit only
+type fakeStorage struct {
+	mu          sync.Mutex
+	processed   map[string]bool
+	deadLetters []deadLetter
+
+	pendingProcessed   []string
+	pendingDeadLetters []deadLetter
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{processed: make(map[string]bool)}
+}
+
+func (s *fakeStorage) SaveMessage(_ context.Context, msg Message) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.processed[msg.ID] {
+		return false, nil
+	}
+	s.pendingProcessed = append(s.pendingProcessed, msg.ID)
+	return true, nil
+}
+
+func (s *fakeStorage) SaveDeadLetter(_ context.Context, msg Message, attempts int, lastErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pendingDeadLetters = append(s.pendingDeadLetters, deadLetter{msg: msg, attempts: attempts, lastErr: lastErr.Error()})
+	return nil
+}
+
+func (s *fakeStorage) RunReadCommitted(ctx context.Context, _ pgx.TxAccessMode, f func(ctx context.Context) error) error {
+	err := f(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err == nil {
+		for _, id := range s.pendingProcessed {
+			s.processed[id] = true
+		}
+		s.deadLetters = append(s.deadLetters, s.pendingDeadLetters...)
+	}
+	s.pendingProcessed, s.pendingDeadLetters = nil, nil
+
+	return err
+}
+
+func (s *fakeStorage) DeadLetters() []deadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]deadLetter(nil), s.deadLetters...)
+}
+
+func TestConsumer_Run(t *testing.T) {
+	type handled struct {
+		mu    sync.Mutex
+		calls map[string]int
+	}
+
+	tests := []struct {
+		name            string
+		messages        []Message
+		handler         func(msg Message, attempt int) error
+		wantCalls       map[string]int
+		wantDeadLetters []string
+	}{
+		{
+			name: "Test 1. Duplicates are handled once.",
+			messages: []Message{
+				{ID: "1", Topic: "topic"},
+				{ID: "2", Topic: "topic"},
+				{ID: "1", Topic: "topic"},
+			},
+			handler:   func(Message, int) error { return nil },
+			wantCalls: map[string]int{"1": 1, "2": 1},
+		},
+		{
+			name: "Test 2. Failed message is retried and not deduplicated by the failed attempt.",
+			messages: []Message{
+				{ID: "1", Topic: "topic"},
+			},
+			handler: func(_ Message, attempt int) error {
+				if attempt < 3 {
+					return errors.New("some error")
+				}
+				return nil
+			},
+			wantCalls: map[string]int{"1": 3},
+		},
+		{
+			name: "Test 3. Exhausted and permanently failed messages are dead-lettered.",
+			messages: []Message{
+				{ID: "1", Topic: "topic"},
+				{ID: "2", Topic: "topic"},
+				{ID: "3", Topic: "unknown"},
+				{ID: "1", Topic: "topic"}, // redelivered dead letter
+			},
+			handler: func(msg Message, _ int) error {
+				if msg.ID == "2" {
+					return Permanent(errors.New("invalid payload"))
+				}
+				return errors.New("some error")
+			},
+			wantCalls:       map[string]int{"1": 3, "2": 1},
+			wantDeadLetters: []string{"1", "2", "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx, cancel = context.WithCancel(context.Background())
+				storage     = newFakeStorage()
+				subscriber  = NewMemorySubscriber(len(tt.messages))
+				h           = handled{calls: make(map[string]int)}
+			)
+			defer cancel()
+
+			c := NewConsumer(subscriber, storage, storage,
+				WithMaxAttempts(3),
+				WithBackoff(time.Millisecond, time.Millisecond),
+			)
+			c.Handle("topic", func(_ context.Context, msg Message) error {
+				h.mu.Lock()
+				h.calls[msg.ID]++
+				attempt := h.calls[msg.ID]
+				h.mu.Unlock()
+
+				return tt.handler(msg, attempt)
+			})
+
+			require.NoError(t, subscriber.Publish(ctx, tt.messages...))
+
+			done := make(chan error)
+			go func() { done <- c.Run(ctx) }()
+
+			assert.Eventually(t, func() bool { return len(subscriber.messages) == 0 }, time.Second, time.Millisecond)
+			require.NoError(t, subscriber.Close())
+			assert.ErrorIs(t, <-done, ErrSubscriberClosed)
+
+			assert.Equal(t, tt.wantCalls, h.calls)
+
+			var deadLetters []string
+			for _, dl := range storage.DeadLetters() {
+				deadLetters = append(deadLetters, dl.msg.ID)
+			}
+			assert.Equal(t, tt.wantDeadLetters, deadLetters)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package inbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FileSubscriber - subscriber reading JSON lines file for local runs: file is tailed for appended
+// messages, committed position is kept in the sibling "<path>.offset" file
+type FileSubscriber struct {
+	path         string
+	pollInterval time.Duration
+
+	mu      sync.Mutex
+	file    *os.File
+	reader  *bufio.Reader
+	partial []byte // incomplete last line
+	offset  int64  // position after the last fetched line
+}
+
+// Check that we implemet contract for consumer
+var _ Subscriber = (*FileSubscriber)(nil)
+
+// NewFileSubscriber - opens file and continues from the committed position
+func NewFileSubscriber(path string) (*FileSubscriber, error) {
+	offset, err := readOffset(offsetPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("inbox: open %s: %w", path, err)
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("inbox: seek %s: %w", path, err)
+	}
+
+	return &FileSubscriber{
+		path:         path,
+		pollInterval: 500 * time.Millisecond,
+		file:         file,
+		reader:       bufio.NewReader(file),
+		offset:       offset,
+	}, nil
+}
+
+func (s *FileSubscriber) Fetch(ctx context.Context) (Message, error) {
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return Message{}, err
+		}
+
+		if line == nil { // end of file, wait for appended lines
+			select {
+			case <-ctx.Done():
+				return Message{}, ctx.Err()
+			case <-time.After(s.pollInterval):
+				continue
+			}
+		}
+
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		var msg Message
+		if err = json.Unmarshal(line, &msg); err != nil {
+			return Message{}, fmt.Errorf("inbox: malformed line at offset %d of %s: %w", s.offset-int64(len(line)), s.path, err)
+		}
+		if msg.ID == "" {
+			return Message{}, fmt.Errorf("inbox: message without id at offset %d of %s", s.offset-int64(len(line)), s.path)
+		}
+
+		return msg, nil
+	}
+}
+
+// readLine - returns next complete line or nil at the end of file
+func (s *FileSubscriber) readLine() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chunk, err := s.reader.ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("inbox: read %s: %w", s.path, err)
+	}
+
+	s.partial = append(s.partial, chunk...)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	line := s.partial
+	s.partial = nil
+	s.offset += int64(len(line))
+
+	return line, nil
+}
+
+// Commit - saves position after the last fetched message
+func (s *FileSubscriber) Commit(context.Context, Message) error {
+	s.mu.Lock()
+	offset := s.offset
+	s.mu.Unlock()
+
+	return writeOffset(offsetPath(s.path), offset)
+}
+
+func (s *FileSubscriber) Close() error {
+	return s.file.Close()
+}
+
+func offsetPath(path string) string {
+	return path + ".offset"
+}
+
+func readOffset(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("inbox: read offset: %w", err)
+	}
+
+	offset, err := strconv.ParseInt(string(bytes.TrimSpace(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("inbox: parse offset %s: %w", path, err)
+	}
+	return offset, nil
+}
+
+// writeOffset - replaces offset file atomically
+func writeOffset(path string, offset int64) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(offset, 10)), 0o644); err != nil {
+		return fmt.Errorf("inbox: write offset: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("inbox: write offset: %w", err)
+	}
+	return nil
+}
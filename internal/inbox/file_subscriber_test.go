@@ -0,0 +1,72 @@
+//go:build test
+
+package inbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSubscriber(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inbox.jsonl")
+
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"id":"1","topic":"topic","payload":{"a":1}}`+"\n"+
+			"not a json\n"+
+			`{"id":"2","topic":"topic"}`+"\n"+
+			`{"id":"3","to`, // incomplete line
+	), 0o644))
+
+	s, err := NewFileSubscriber(path)
+	require.NoError(t, err)
+	s.pollInterval = time.Millisecond
+
+	msg, err := s.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "1", msg.ID)
+	assert.JSONEq(t, `{"a":1}`, string(msg.Payload))
+	require.NoError(t, s.Commit(ctx, msg))
+
+	_, err = s.Fetch(ctx)
+	assert.Error(t, err, "malformed line")
+
+	msg, err = s.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2", msg.ID)
+
+	// incomplete line is not returned until it is finished
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = s.Fetch(timeoutCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`pic":"topic"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	msg, err = s.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "3", msg.ID)
+	require.NoError(t, s.Close())
+
+	// reopened subscriber continues after the last committed message
+	s, err = NewFileSubscriber(path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	_, err = s.Fetch(ctx)
+	assert.Error(t, err, "malformed line")
+
+	msg, err = s.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2", msg.ID)
+}
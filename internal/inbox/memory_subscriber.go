@@ -0,0 +1,66 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrSubscriberClosed - subscriber is closed and won't return messages anymore
+var ErrSubscriberClosed = errors.New("inbox: subscriber is closed")
+
+// MemorySubscriber - in-memory subscriber for local runs and tests
+type MemorySubscriber struct {
+	messages chan Message
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// Check that we implemet contract for consumer
+var _ Subscriber = (*MemorySubscriber)(nil)
+
+// NewMemorySubscriber - returns subscriber with buffer of the given size
+func NewMemorySubscriber(buffer int) *MemorySubscriber {
+	return &MemorySubscriber{
+		messages: make(chan Message, buffer),
+		closed:   make(chan struct{}),
+	}
+}
+
+// Publish - puts messages into subscriber, blocks while buffer is full
+func (s *MemorySubscriber) Publish(ctx context.Context, msgs ...Message) error {
+	for _, msg := range msgs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.closed:
+			return ErrSubscriberClosed
+		case s.messages <- msg:
+		}
+	}
+	return nil
+}
+
+func (s *MemorySubscriber) Fetch(ctx context.Context) (Message, error) {
+	select {
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	case <-s.closed:
+		return Message{}, ErrSubscriberClosed
+	case msg := <-s.messages:
+		return msg, nil
+	}
+}
+
+// Commit - no-op, messages are not redelivered
+func (s *MemorySubscriber) Commit(context.Context, Message) error {
+	return nil
+}
+
+func (s *MemorySubscriber) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	return nil
+}
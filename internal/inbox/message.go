@@ -0,0 +1,54 @@
+// Package inbox - idempotent consumption of inbound events: message is handled
+// and remembered as processed in the same transaction, so redelivered duplicates are skipped
+package inbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// Message - inbound event
+type Message struct {
+	// ID - unique id of the message assigned by producer, used for deduplication
+	ID      string            `json:"id"`
+	Topic   string            `json:"topic"`
+	Key     string            `json:"key,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Payload json.RawMessage   `json:"payload"`
+}
+
+// Handler - handles message, ctx carries the inbox transaction
+type Handler func(ctx context.Context, msg Message) error
+
+// Subscriber - source of inbound messages with at-least-once delivery
+type Subscriber interface {
+	// Fetch - blocks until next message is available or ctx is done
+	Fetch(ctx context.Context) (Message, error)
+	// Commit - acknowledges message and all messages fetched before it
+	Commit(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// permanentError - error which won't be fixed by retry
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent - marks handler error as permanent: message goes to dead letters without retries
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent - reports whether error is marked as permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
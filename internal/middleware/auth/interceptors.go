@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"sync"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/audit"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// authorizationHeader - "Bearer <token>", grpc-gateway passes HTTP Authorization header as is
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// AdminUnaryInterceptor - admits calls of methods marked with (admin) = true option only with admin token,
+// such calls are recorded on behalf of audit.ActorAdmin. Admin methods are disabled when token is empty
+func AdminUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !isAdmin(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if token == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin methods are disabled")
+		}
+		if !validToken(ctx, token) {
+			return nil, status.Error(codes.Unauthenticated, "admin token is required")
+		}
+
+		return handler(audit.WithActor(ctx, audit.ActorAdmin), req)
+	}
+}
+
+func validToken(ctx context.Context, token string) bool {
+	values := metadata.ValueFromIncomingContext(ctx, authorizationHeader)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(values[0], bearerPrefix)), []byte(token)) == 1
+}
+
+// cache - whether method is admin one, by full method name
+var cache sync.Map
+
+// isAdmin - fullMethod is "/<service>/<method>", unknown methods are not admin ones
+func isAdmin(fullMethod string) bool {
+	if v, ok := cache.Load(fullMethod); ok {
+		return v.(bool)
+	}
+
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	res := false
+	if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		if md, ok := desc.(protoreflect.MethodDescriptor); ok {
+			res, _ = proto.GetExtension(md.Options(), pb.E_Admin).(bool)
+		}
+	}
+	cache.Store(fullMethod, res)
+
+	return res
+}
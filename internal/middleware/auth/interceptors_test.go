@@ -0,0 +1,81 @@
+//go:build test
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/audit"
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminUnaryInterceptor(t *testing.T) {
+	withAuthorization := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", value))
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		ctx       context.Context
+		method    string
+		wantCode  codes.Code
+		wantActor string
+	}{
+		{
+			name:      "Test 1. Positive. Admin method with admin token.",
+			token:     "secret",
+			ctx:       withAuthorization("Bearer secret"),
+			method:    pb.OrdersManagementSystemService_SetLogLevel_FullMethodName,
+			wantCode:  codes.OK,
+			wantActor: audit.ActorAdmin,
+		},
+		{
+			name:      "Test 2. Positive. Method which is not admin one needs no token.",
+			token:     "secret",
+			ctx:       context.Background(),
+			method:    pb.OrdersManagementSystemService_CreateOrder_FullMethodName,
+			wantCode:  codes.OK,
+			wantActor: audit.ActorAnonymous,
+		},
+		{
+			name:     "Test 3. Negative. Admin method without token.",
+			token:    "secret",
+			ctx:      context.Background(),
+			method:   pb.OrdersManagementSystemService_ListOrderAuditLog_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Test 4. Negative. Admin method with wrong token.",
+			token:    "secret",
+			ctx:      withAuthorization("Bearer secret2"),
+			method:   pb.OrdersManagementSystemService_RequeueOutboxMessage_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Test 5. Negative. Admin methods are disabled without token of server.",
+			ctx:      withAuthorization("Bearer "),
+			method:   pb.OrdersManagementSystemService_GetLogLevel_FullMethodName,
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actor string
+			_, err := AdminUnaryInterceptor(tt.token)(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					actor = audit.Actor(ctx)
+					return nil, nil
+				})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantActor, actor)
+		})
+	}
+}
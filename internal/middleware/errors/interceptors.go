@@ -5,6 +5,8 @@ import (
 	stderrors "errors"
 
 	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	grpcutils "github.com/moguchev/microservices_courcse/orders_management_system/pkg/grpc_utils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,19 +21,61 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 	) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
 
-		if _, ok := status.FromError(err); ok {
-			return
-		}
+		return resp, ToStatusError(err)
+	}
+}
 
-		switch {
-		case stderrors.Is(err, models.ErrAlreadyExists):
-			err = status.Error(codes.AlreadyExists, err.Error())
-		case stderrors.Is(err, models.ErrUnimplemented):
-			err = status.Error(codes.Unimplemented, err.Error())
-		default:
-			err = status.Error(codes.Internal, err.Error())
-		}
+func ErrorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return ToStatusError(handler(srv, ss))
+	}
+}
 
-		return
+// ToStatusError - converts domain error to gRPC status error
+func ToStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErr *models.ValidationError
+
+	switch {
+	case stderrors.As(err, &validationErr):
+		err = grpcutils.RPCBadRequestError(fieldViolationsToErrdetails(validationErr.Violations)...)
+	case stderrors.Is(err, models.ErrAlreadyExists):
+		err = status.Error(codes.AlreadyExists, err.Error())
+	case stderrors.Is(err, models.ErrNotFound):
+		err = status.Error(codes.NotFound, err.Error())
+	case stderrors.Is(err, models.ErrDeliverySlotUnavailable),
+		stderrors.Is(err, models.ErrInvalidOrderStatus),
+		stderrors.Is(err, models.ErrPaymentMismatch),
+		stderrors.Is(err, models.ErrInvalidOutboxMessageStatus):
+		err = status.Error(codes.FailedPrecondition, err.Error())
+	case stderrors.Is(err, models.ErrVersionConflict):
+		err = status.Error(codes.Aborted, err.Error())
+	case stderrors.Is(err, models.ErrWatchLagged):
+		err = status.Error(codes.Unavailable, err.Error())
+	case stderrors.Is(err, models.ErrUnimplemented):
+		err = status.Error(codes.Unimplemented, err.Error())
+	default:
+		err = status.Error(codes.Internal, err.Error())
+	}
+
+	return err
+}
+
+func fieldViolationsToErrdetails(vs []models.FieldViolation) []*errdetails.BadRequest_FieldViolation {
+	res := make([]*errdetails.BadRequest_FieldViolation, len(vs))
+	for i, v := range vs {
+		res[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		}
 	}
+	return res
 }
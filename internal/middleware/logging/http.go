@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type httpCallKey struct{}
+
+// httpCall - REST call, method and user are saved by interceptor of the gateway, error - by its error handler
+type httpCall struct {
+	method string
+	userID uint64
+	err    error
+}
+
+// LogHTTPMiddleware - adds request_id to logs of REST call served by in-process gateway
+// and writes single access log line with method, user, status code and latency
+func LogHTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		ctx := logger.WithRequestID(r.Context(), requestID)
+
+		call := &httpCall{}
+		ctx = context.WithValue(ctx, httpCallKey{}, call)
+
+		start := time.Now()
+		next.ServeHTTP(w, r.WithContext(ctx))
+
+		if call.method != "" {
+			ctx = logger.WithMethod(ctx, call.method)
+		}
+		if call.userID != 0 {
+			ctx = logger.WithUserID(ctx, call.userID)
+		}
+		logAccess(ctx, "http request", time.Since(start), call.err)
+	})
+}
+
+// LogHTTPUnaryInterceptor - adds method and user_id of decoded request to logs of REST call,
+// it intercepts calls of in-process gateway which bypass interceptors of gRPC server
+func LogHTTPUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		call, _ := ctx.Value(httpCallKey{}).(*httpCall)
+
+		ctx = logger.WithMethod(ctx, info.FullMethod)
+		if call != nil {
+			call.method = info.FullMethod
+		}
+		if r, ok := req.(userIDGetter); ok {
+			ctx = logger.WithUserID(ctx, r.GetUserId())
+			if call != nil {
+				call.userID = r.GetUserId()
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// LogHTTPResponse - logs response of REST call with masked sensitive fields on debug level,
+// it is forward response option of the gateway
+func LogHTTPResponse(ctx context.Context, _ http.ResponseWriter, resp proto.Message) error {
+	logPayload(ctx, nil, resp)
+	return nil
+}
+
+// SetHTTPError - saves error of REST call for access log, it is called by error handler of the gateway.
+// Method is known from the gateway even when request is rejected before the call
+func SetHTTPError(ctx context.Context, err error) {
+	if call, ok := ctx.Value(httpCallKey{}).(*httpCall); ok {
+		call.err = err
+		if method, ok := runtime.RPCMethod(ctx); ok && call.method == "" {
+			call.method = method
+		}
+	}
+}
@@ -2,11 +2,31 @@ package logging
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
+	middleware_errors "github.com/moguchev/microservices_courcse/orders_management_system/internal/middleware/errors"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/redact"
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// RequestIDHeader - id of the request passed by caller or generated by server, it is returned in response header
+const RequestIDHeader = "x-request-id"
+
+// userIDGetter - request made on behalf of user
+type userIDGetter interface {
+	GetUserId() uint64
+}
+
+// LogErrorUnaryInterceptor - adds request_id, user_id and method to logs of the call
+// and writes single access log line with status code and latency
 func LogErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -14,23 +34,112 @@ func LogErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		logCtx := logger.ToContext(context.Background(),
-			logger.FromContext(ctx).With(
-				"operation", info.FullMethod,
-				"component", "middleware",
-			),
-		)
-
-		logger.Debug(logCtx, "receive request")
+		ctx = withRequestFields(ctx, info.FullMethod)
+		if r, ok := req.(userIDGetter); ok {
+			ctx = logger.WithUserID(ctx, r.GetUserId())
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, logger.RequestID(ctx)))
+
+		start := time.Now()
 		resp, err = handler(ctx, req)
-		logger.Debug(logCtx, "handle request")
 
-		if err != nil {
-			// 4ХХ -> warn
-			// 5ХХ -> Error
-			logger.Error(logCtx, err.Error())
+		logAccess(ctx, "grpc request", time.Since(start), err)
+		logPayload(ctx, req, resp)
+
+		return resp, err
+	}
+}
+
+// LogErrorStreamInterceptor - adds request_id and method to logs of the stream
+// and writes single access log line with status code and duration when stream is closed
+func LogErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := withRequestFields(ss.Context(), info.FullMethod)
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, logger.RequestID(ctx)))
+
+		start := time.Now()
+		err := handler(srv, &loggedServerStream{ServerStream: ss, ctx: ctx})
+
+		logAccess(ctx, "grpc stream", time.Since(start), err)
+
+		return err
+	}
+}
+
+// withRequestFields - returns ctx with method and request id taken from metadata or generated
+func withRequestFields(ctx context.Context, method string) context.Context {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			requestID = values[0]
 		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
 
+	return logger.WithMethod(logger.WithRequestID(ctx, requestID), method)
+}
+
+// logAccess - client errors are logged as warnings, server errors as errors
+func logAccess(ctx context.Context, message string, latency time.Duration, err error) {
+	code := status.Code(middleware_errors.ToStatusError(err))
+
+	fields := []zap.Field{
+		zap.String("code", code.String()),
+		zap.Duration("latency", latency),
+		zap.String("component", "middleware"),
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	if l := logger.FromContext(ctx).Desugar(); l.Core().Enabled(accessLevel(code)) {
+		l.Log(accessLevel(code), message, fields...)
+	}
+}
+
+// logPayload - request and response with masked sensitive fields are logged on debug level,
+// e.g. for requests in debug window
+func logPayload(ctx context.Context, req, resp interface{}) {
+	l := logger.FromContext(ctx)
+	if !l.Level().Enabled(zapcore.DebugLevel) {
 		return
 	}
+
+	kvs := make([]interface{}, 0, 4)
+	if msg, ok := req.(proto.Message); ok {
+		kvs = append(kvs, "request", redact.JSON(msg))
+	}
+	if msg, ok := resp.(proto.Message); ok && msg != nil {
+		kvs = append(kvs, "response", redact.JSON(msg))
+	}
+	l.Debugw("grpc payload", kvs...)
+}
+
+func accessLevel(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		return zapcore.InfoLevel
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss,
+		codes.DeadlineExceeded, codes.Unimplemented:
+		return zapcore.ErrorLevel
+	default:
+		return zapcore.WarnLevel
+	}
+}
+
+// loggedServerStream - server stream with request fields in context
+type loggedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedServerStream) Context() context.Context {
+	return s.ctx
 }
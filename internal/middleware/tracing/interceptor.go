@@ -3,12 +3,12 @@ package tracing
 import (
 	"context"
 
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
-	"github.com/uber/jaeger-client-go"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/redact"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -16,43 +16,103 @@ const (
 	traceIDKey = "x-trace-id"
 )
 
-func DebugOpenTracingUnaryServerInterceptor(logRequest, logResponse bool) grpc.UnaryServerInterceptor {
+// DebugTracingUnaryServerInterceptor - starts span when there is none, trace id is sent in x-trace-id header,
+// request and response with masked sensitive fields are added to the span as events
+func DebugTracingUnaryServerInterceptor(logRequest, logResponse bool) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		span := opentracing.SpanFromContext(ctx)
-		if span == nil {
-			span, ctx = opentracing.StartSpanFromContext(ctx, info.FullMethod)
-			defer span.Finish()
-
-			spanContext, ok := span.Context().(jaeger.SpanContext)
-			if ok {
-				ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(traceIDKey, spanContext.TraceID().String()))
-
-				header := metadata.New(map[string]string{traceIDKey: spanContext.TraceID().String()})
-				err := grpc.SendHeader(ctx, header)
-				if err != nil {
-					return nil, err
-				}
+		span := trace.SpanFromContext(ctx)
+		if !span.SpanContext().IsValid() {
+			ctx, span = tracing.Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+			defer span.End()
+		}
+
+		if span.SpanContext().IsSampled() {
+			header := metadata.Pairs(traceIDKey, span.SpanContext().TraceID().String())
+			if err := grpc.SetHeader(ctx, header); err != nil {
+				return nil, err
 			}
 		}
 
 		if pbMsg, ok := req.(proto.Message); ok && logRequest {
-			if jsonRequest, err := protojson.Marshal(pbMsg); err == nil {
-				span.LogKV("grpc_request", string(jsonRequest))
-			}
+			addMessageEvent(span, "grpc_request", pbMsg)
 		}
 
 		res, err := handler(ctx, req)
 		if err != nil {
-			ext.Error.Set(span, true)
-			span.LogKV("grpc_error", err)
-		} else {
-			if pbMsg, ok := res.(proto.Message); ok && logResponse {
-				if jsonResponse, err := protojson.Marshal(pbMsg); err == nil {
-					span.LogKV("grpc_response", string(jsonResponse))
-				}
-			}
+			tracing.RecordError(span, err)
+		} else if pbMsg, ok := res.(proto.Message); ok && logResponse {
+			addMessageEvent(span, "grpc_response", pbMsg)
 		}
 
 		return res, err
 	}
 }
+
+// DebugTracingStreamServerInterceptor - starts span for the whole stream when there is none,
+// sent and received messages are added to the span as events
+func DebugTracingStreamServerInterceptor(logRequest, logResponse bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+
+		span := trace.SpanFromContext(ctx)
+		if !span.SpanContext().IsValid() {
+			ctx, span = tracing.Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+			defer span.End()
+		}
+
+		if span.SpanContext().IsSampled() {
+			header := metadata.Pairs(traceIDKey, span.SpanContext().TraceID().String())
+			if err := ss.SetHeader(header); err != nil {
+				return err
+			}
+		}
+
+		err := handler(srv, &tracedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			span:         span,
+			logRequest:   logRequest,
+			logResponse:  logResponse,
+		})
+		tracing.RecordError(span, err)
+
+		return err
+	}
+}
+
+// tracedServerStream - server stream with span in context
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	span trace.Span
+
+	logRequest  bool
+	logResponse bool
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tracedServerStream) SendMsg(m interface{}) error {
+	if pbMsg, ok := m.(proto.Message); ok && s.logResponse {
+		addMessageEvent(s.span, "grpc_response", pbMsg)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *tracedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if pbMsg, ok := m.(proto.Message); ok && err == nil && s.logRequest {
+		addMessageEvent(s.span, "grpc_request", pbMsg)
+	}
+	return err
+}
+
+// addMessageEvent - sensitive fields of msg are masked
+func addMessageEvent(span trace.Span, name string, msg proto.Message) {
+	if !span.IsRecording() {
+		return
+	}
+	span.AddEvent(name, trace.WithAttributes(attribute.String("message", redact.JSON(msg))))
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+// Admin - inspection and manual requeue of dead messages
+type Admin struct {
+	storage   Storage
+	txManager TransactionManager
+}
+
+// NewAdmin - returns admin
+func NewAdmin(storage Storage, txManager TransactionManager) *Admin {
+	return &Admin{
+		storage:   storage,
+		txManager: txManager,
+	}
+}
+
+// ListDead - returns page of dead messages after the given id
+func (a *Admin) ListDead(ctx context.Context, afterID int64, limit uint64) ([]Message, error) {
+	return a.storage.ListDead(ctx, afterID, limit)
+}
+
+// GetMessage - returns message by id
+func (a *Admin) GetMessage(ctx context.Context, id int64) (*Message, error) {
+	return a.storage.GetMessage(ctx, id)
+}
+
+// Requeue - returns dead message to pending with fresh attempts, last error is kept for history
+func (a *Admin) Requeue(ctx context.Context, id int64) (*Message, error) {
+	var msg *Message
+	err := a.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			var err error
+			if msg, err = a.storage.GetMessageForUpdate(txCtx, id); err != nil {
+				return err
+			}
+
+			if msg.Status != StatusDead {
+				return fmt.Errorf("outbox message %d is %s: %w", id, msg.Status, models.ErrInvalidOutboxMessageStatus)
+			}
+
+			msg.Status = StatusPending
+			msg.Attempts = 0
+			msg.NextAttemptAt = time.Now()
+
+			return a.storage.UpdateDelivery(txCtx, msg)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
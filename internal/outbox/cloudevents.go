@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ContentMode - CloudEvents content mode of HTTP message
+type ContentMode string
+
+const (
+	// ContentModeStructured - whole event is JSON body
+	ContentModeStructured ContentMode = "structured"
+	// ContentModeBinary - attributes are ce-* headers, body is event data
+	ContentModeBinary ContentMode = "binary"
+)
+
+const (
+	cloudEventsSpecVersion     = "1.0"
+	cloudEventsContentType     = "application/cloudevents+json"
+	cloudEventsDataContentType = "application/json"
+)
+
+// CloudEventsConfig - encoder configuration
+type CloudEventsConfig struct {
+	// Source - URI-reference identifying producer, e.g. "/orders-management-system"
+	Source string
+	Mode   ContentMode
+}
+
+// Envelope - encoded event ready to be sent over HTTP
+type Envelope struct {
+	Headers map[string]string
+	Body    []byte
+}
+
+// CloudEventsEncoder - encodes outbox messages as CloudEvents 1.0
+type CloudEventsEncoder struct {
+	cfg CloudEventsConfig
+}
+
+// NewCloudEventsEncoder - returns encoder, structured mode is used by default
+func NewCloudEventsEncoder(cfg CloudEventsConfig) (*CloudEventsEncoder, error) {
+	if cfg.Source == "" {
+		return nil, fmt.Errorf("outbox: cloudevents: source is required")
+	}
+
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = ContentModeStructured
+	case ContentModeStructured, ContentModeBinary:
+	default:
+		return nil, fmt.Errorf("outbox: cloudevents: unknown content mode %q", cfg.Mode)
+	}
+
+	return &CloudEventsEncoder{cfg: cfg}, nil
+}
+
+// cloudEvent - structured mode representation
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	Sequence        string          `json:"sequence,omitempty"`
+	TraceParent     string          `json:"traceparent,omitempty"`
+	TraceState      string          `json:"tracestate,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// eventData - data of order event, Payload - change made by the event
+type eventData struct {
+	OrderID  string          `json:"order_id"`
+	Sequence int64           `json:"sequence"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+// Encode - returns envelope of message in configured content mode
+func (e *CloudEventsEncoder) Encode(msg Message) (Envelope, error) {
+	data, err := json.Marshal(eventData{
+		OrderID:  msg.AggregateID,
+		Sequence: msg.Sequence,
+		Payload:  msg.Payload,
+	})
+	if err != nil {
+		return Envelope{}, fmt.Errorf("outbox: cloudevents: marshal data: %w", err)
+	}
+
+	event := cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              strconv.FormatInt(msg.ID, 10),
+		Source:          e.cfg.Source,
+		Type:            msg.EventType,
+		Subject:         msg.AggregateID,
+		DataContentType: cloudEventsDataContentType,
+		TraceParent:     msg.TraceParent,
+		TraceState:      msg.TraceState,
+		Data:            data,
+	}
+	if !msg.CreatedAt.IsZero() {
+		event.Time = msg.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	if msg.Sequence > 0 {
+		event.Sequence = strconv.FormatInt(msg.Sequence, 10)
+	}
+
+	if e.cfg.Mode == ContentModeBinary {
+		return binaryEnvelope(event), nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("outbox: cloudevents: marshal event: %w", err)
+	}
+
+	return Envelope{
+		Headers: map[string]string{"Content-Type": cloudEventsContentType},
+		Body:    body,
+	}, nil
+}
+
+// binaryEnvelope - attributes go to ce-* headers, distributed tracing extension uses W3C headers
+func binaryEnvelope(event cloudEvent) Envelope {
+	headers := map[string]string{
+		"Content-Type":   event.DataContentType,
+		"ce-specversion": event.SpecVersion,
+		"ce-id":          event.ID,
+		"ce-source":      event.Source,
+		"ce-type":        event.Type,
+	}
+
+	optional := map[string]string{
+		"ce-subject":  event.Subject,
+		"ce-time":     event.Time,
+		"ce-sequence": event.Sequence,
+		"traceparent": event.TraceParent,
+		"tracestate":  event.TraceState,
+	}
+	for k, v := range optional {
+		if v != "" {
+			headers[k] = v
+		}
+	}
+
+	return Envelope{
+		Headers: headers,
+		Body:    event.Data,
+	}
+}
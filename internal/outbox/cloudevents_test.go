@@ -0,0 +1,154 @@
+//go:build test
+
+package outbox
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudEventsEncoder_Encode(t *testing.T) {
+	msg := Message{
+		ID:          42,
+		AggregateID: "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63",
+		Sequence:    2,
+		EventType:   "order.paid",
+		CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:  "vendor=opaque",
+	}
+
+	tests := []struct {
+		name        string
+		mode        ContentMode
+		eventType   string
+		payload     []byte
+		wantHeaders map[string]string
+		wantBody    string
+	}{
+		{
+			name: "Test 1. Structured mode.",
+			mode: ContentModeStructured,
+			wantHeaders: map[string]string{
+				"Content-Type": "application/cloudevents+json",
+			},
+			wantBody: `{
+				"specversion": "1.0",
+				"id": "42",
+				"source": "/orders-management-system",
+				"type": "order.paid",
+				"subject": "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63",
+				"time": "2024-01-02T03:04:05Z",
+				"datacontenttype": "application/json",
+				"sequence": "2",
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"tracestate": "vendor=opaque",
+				"data": {"order_id": "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63", "sequence": 2}
+			}`,
+		},
+		{
+			name: "Test 2. Binary mode.",
+			mode: ContentModeBinary,
+			wantHeaders: map[string]string{
+				"Content-Type":   "application/json",
+				"ce-specversion": "1.0",
+				"ce-id":          "42",
+				"ce-source":      "/orders-management-system",
+				"ce-type":        "order.paid",
+				"ce-subject":     "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63",
+				"ce-time":        "2024-01-02T03:04:05Z",
+				"ce-sequence":    "2",
+				"traceparent":    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"tracestate":     "vendor=opaque",
+			},
+			wantBody: `{"order_id": "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63", "sequence": 2}`,
+		},
+		{
+			name:      "Test 3. Binary mode. Data carries payload of the event.",
+			mode:      ContentModeBinary,
+			eventType: "order.updated",
+			payload:   []byte(`{"fields": ["delivery_info.delivery_date"], "delivery_date": "2024-01-03T10:00:00Z"}`),
+			wantHeaders: map[string]string{
+				"Content-Type":   "application/json",
+				"ce-specversion": "1.0",
+				"ce-id":          "42",
+				"ce-source":      "/orders-management-system",
+				"ce-type":        "order.updated",
+				"ce-subject":     "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63",
+				"ce-time":        "2024-01-02T03:04:05Z",
+				"ce-sequence":    "2",
+				"traceparent":    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"tracestate":     "vendor=opaque",
+			},
+			wantBody: `{
+				"order_id": "0b5bf5c2-9a5b-4c4e-8a0c-0d5fd07c0f63",
+				"sequence": 2,
+				"payload": {"fields": ["delivery_info.delivery_date"], "delivery_date": "2024-01-03T10:00:00Z"}
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoder, err := NewCloudEventsEncoder(CloudEventsConfig{
+				Source: "/orders-management-system",
+				Mode:   tt.mode,
+			})
+			require.NoError(t, err)
+
+			msg := msg
+			if tt.eventType != "" {
+				msg.EventType = tt.eventType
+			}
+			msg.Payload = tt.payload
+
+			got, err := encoder.Encode(msg)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantHeaders, got.Headers)
+			assert.JSONEq(t, tt.wantBody, string(got.Body))
+		})
+	}
+}
+
+func TestNewCloudEventsEncoder(t *testing.T) {
+	_, err := NewCloudEventsEncoder(CloudEventsConfig{Mode: ContentModeBinary})
+	assert.Error(t, err)
+
+	_, err = NewCloudEventsEncoder(CloudEventsConfig{Source: "/oms", Mode: "batched"})
+	assert.Error(t, err)
+}
+
+func TestHTTPPublisher_Publish(t *testing.T) {
+	var (
+		gotHeaders http.Header
+		gotBody    []byte
+		status     = http.StatusAccepted
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	encoder, err := NewCloudEventsEncoder(CloudEventsConfig{Source: "/oms", Mode: ContentModeBinary})
+	require.NoError(t, err)
+
+	publisher := NewHTTPPublisher(srv.URL, srv.Client(), encoder)
+	msg := Message{ID: 1, AggregateID: "order-1", Sequence: 1, EventType: "order.created"}
+
+	require.NoError(t, publisher.Publish(context.Background(), msg))
+	assert.Equal(t, "order.created", gotHeaders.Get("ce-type"))
+	assert.Equal(t, "order-1", gotHeaders.Get("ce-subject"))
+	assert.JSONEq(t, `{"order_id": "order-1", "sequence": 1}`, string(gotBody))
+
+	status = http.StatusServiceUnavailable
+	assert.Error(t, publisher.Publish(context.Background(), msg))
+}
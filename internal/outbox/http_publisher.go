@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPPublisher - publisher POSTing CloudEvents to the endpoint
+type HTTPPublisher struct {
+	url     string
+	client  *http.Client
+	encoder *CloudEventsEncoder
+}
+
+// Check that we implemet contract for relay
+var _ Publisher = (*HTTPPublisher)(nil)
+
+// NewHTTPPublisher - returns publisher
+func NewHTTPPublisher(url string, client *http.Client, encoder *CloudEventsEncoder) *HTTPPublisher {
+	return &HTTPPublisher{
+		url:     url,
+		client:  client,
+		encoder: encoder,
+	}
+}
+
+func (p *HTTPPublisher) Publish(ctx context.Context, msg Message) error {
+	envelope, err := p.encoder.Encode(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(envelope.Body))
+	if err != nil {
+		return fmt.Errorf("outbox: http publisher: new request: %w", err)
+	}
+	for k, v := range envelope.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("outbox: http publisher: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("outbox: http publisher: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
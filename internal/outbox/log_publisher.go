@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+// LogPublisher - publisher writing messages to log for local runs
+type LogPublisher struct {
+	// Encoder - optional, when set messages are logged as CloudEvents envelopes
+	Encoder *CloudEventsEncoder
+}
+
+// Check that we implemet contract for relay
+var _ Publisher = LogPublisher{}
+
+func (p LogPublisher) Publish(ctx context.Context, msg Message) error {
+	if p.Encoder != nil {
+		envelope, err := p.Encoder.Encode(msg)
+		if err != nil {
+			return err
+		}
+		logger.InfoKV(ctx, "outbox: message published",
+			"headers", envelope.Headers,
+			"body", string(envelope.Body),
+		)
+		return nil
+	}
+
+	logger.InfoKV(ctx, "outbox: message published",
+		"id", msg.ID,
+		"aggregate_id", msg.AggregateID,
+		"sequence", msg.Sequence,
+		"event_type", msg.EventType,
+		"created_at", msg.CreatedAt,
+	)
+	return nil
+}
@@ -0,0 +1,61 @@
+// Package outbox - relay of events saved to outbox table in the same transaction as business data
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Status - delivery status of outbox message
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	// StatusDead - delivery attempts are exhausted, message waits for manual requeue
+	StatusDead Status = "dead"
+)
+
+// Message - outbox row
+type Message struct {
+	ID int64
+	// AggregateID - id of the entity the event belongs to
+	AggregateID string
+	// Sequence - number of the event within aggregate starting from 1,
+	// events of one aggregate are published strictly in sequence order
+	Sequence  int64
+	EventType string
+	// Payload - JSON of the change made by the event, empty for messages saved without it
+	Payload   []byte
+	CreatedAt time.Time
+
+	Status        Status
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	SentAt        time.Time
+
+	// TraceParent, TraceState - W3C trace context of the transaction that saved the message
+	TraceParent string
+	TraceState  string
+}
+
+// Partition - subset of aggregates handled by one relay worker,
+// all messages of an aggregate belong to the same partition
+type Partition struct {
+	Index int
+	Count int
+}
+
+// Publisher - delivers message to the outer world
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Waker - source of wake-ups of relay when new messages are saved
+type Waker interface {
+	// C - returns channel closed on next wake-up
+	C() <-chan struct{}
+	// Listening - reports whether wake-ups are delivered, relay polls with poll interval otherwise
+	Listening() bool
+}
@@ -0,0 +1,19 @@
+package outbox
+
+import "context"
+
+// MultiPublisher - publishes message to all publishers in order, stops on the first error.
+// Message is retried as a whole, so publishers preceding the failed one receive it again
+type MultiPublisher []Publisher
+
+// Check that we implemet contract for relay
+var _ Publisher = MultiPublisher(nil)
+
+func (m MultiPublisher) Publish(ctx context.Context, msg Message) error {
+	for _, p := range m {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+// NotifyChannel - postgres channel notified on commit of transaction with new outbox messages
+const NotifyChannel = "orders_outbox_messages"
+
+// ConnectionPool - source of dedicated connection for LISTEN
+type ConnectionPool interface {
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// PostgresListener - waker on postgres LISTEN/NOTIFY, it holds a dedicated connection
+// and reconnects when the connection is lost
+type PostgresListener struct {
+	pool          ConnectionPool
+	channel       string
+	retryInterval time.Duration
+
+	listening atomic.Bool
+	mu        sync.Mutex
+	wakeup    chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Check that we implemet contract for relay
+var _ Waker = (*PostgresListener)(nil)
+
+// NewPostgresListener - returns listener of the channel
+func NewPostgresListener(pool ConnectionPool, channel string) *PostgresListener {
+	return &PostgresListener{
+		pool:          pool,
+		channel:       channel,
+		retryInterval: 5 * time.Second,
+		wakeup:        make(chan struct{}),
+	}
+}
+
+// C - returns channel closed on next notification
+func (l *PostgresListener) C() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.wakeup
+}
+
+// Listening - reports whether LISTEN connection is alive
+func (l *PostgresListener) Listening() bool {
+	return l.listening.Load()
+}
+
+// Start - starts listener in background, it is stopped by closer
+func (l *PostgresListener) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+	l.done = make(chan struct{})
+
+	closer.Add(l.Stop)
+
+	go func() {
+		defer close(l.done)
+		l.Run(ctx)
+	}()
+}
+
+// Stop - stops listener and closes its connection
+func (l *PostgresListener) Stop(ctx context.Context) error {
+	if l.cancel == nil {
+		return nil
+	}
+	l.cancel()
+
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("outbox: stop listener: %w", ctx.Err())
+	}
+}
+
+// Run - listens for notifications until ctx is done
+func (l *PostgresListener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+
+		// relays fall back to polling until connection is restored
+		l.listening.Store(false)
+		l.broadcast()
+
+		if ctx.Err() != nil {
+			return
+		}
+		logger.ErrorKV(ctx, "outbox: listener connection is lost", "error", err.Error())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+func (l *PostgresListener) listen(ctx context.Context) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	// connection in LISTEN state must not return to pool
+	conn := pooled.Hijack()
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	l.listening.Store(true)
+	// messages committed while connection was down
+	l.broadcast()
+
+	for {
+		if _, err = conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		l.broadcast()
+	}
+}
+
+// broadcast - wakes up all waiters
+func (l *PostgresListener) broadcast() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	close(l.wakeup)
+	l.wakeup = make(chan struct{})
+}
@@ -0,0 +1,317 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Relay - publishes pending outbox messages, failed messages are retried with exponential backoff
+// and become dead after max attempts. Messages are claimed for a lease in a short transaction
+// and published outside of it, message of crashed relay is published again after its lease expires.
+// Messages are split into partitions by aggregate and partitions are published in parallel,
+// messages of one aggregate are published one by one in sequence order: a failed or dead message
+// holds back the following messages of its aggregate until it is sent
+type Relay struct {
+	storage   Storage
+	txManager TransactionManager
+	publisher Publisher
+
+	partitions     int
+	batchSize      uint64
+	pollInterval   time.Duration
+	waker          Waker
+	listenInterval time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	lease          time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Option - relay option
+type Option func(r *Relay)
+
+// WithPartitions - number of partitions published in parallel
+func WithPartitions(n int) Option {
+	return func(r *Relay) {
+		r.partitions = n
+	}
+}
+
+// WithBatchSize - max number of messages claimed per batch
+func WithBatchSize(n uint64) Option {
+	return func(r *Relay) {
+		r.batchSize = n
+	}
+}
+
+// WithPollInterval - pause between polls when there are no due messages
+func WithPollInterval(d time.Duration) Option {
+	return func(r *Relay) {
+		r.pollInterval = d
+	}
+}
+
+// WithWaker - wakes up relay on new messages, while waker is listening relay polls with listen interval
+// to pick up retries, otherwise it falls back to poll interval
+func WithWaker(w Waker, listenInterval time.Duration) Option {
+	return func(r *Relay) {
+		r.waker = w
+		r.listenInterval = listenInterval
+	}
+}
+
+// WithMaxAttempts - number of delivery attempts before message becomes dead
+func WithMaxAttempts(n int) Option {
+	return func(r *Relay) {
+		r.maxAttempts = n
+	}
+}
+
+// WithBackoff - exponential backoff between delivery attempts
+func WithBackoff(initial, max time.Duration) Option {
+	return func(r *Relay) {
+		r.initialBackoff = initial
+		r.maxBackoff = max
+	}
+}
+
+// WithLease - time claimed messages are hidden from other relays, it must exceed time of publishing of a batch
+func WithLease(d time.Duration) Option {
+	return func(r *Relay) {
+		r.lease = d
+	}
+}
+
+// NewRelay - returns relay
+func NewRelay(storage Storage, txManager TransactionManager, publisher Publisher, opts ...Option) *Relay {
+	r := &Relay{
+		storage:        storage,
+		txManager:      txManager,
+		publisher:      publisher,
+		partitions:     8,
+		batchSize:      100,
+		pollInterval:   time.Second,
+		maxAttempts:    10,
+		initialBackoff: time.Second,
+		maxBackoff:     time.Hour,
+		lease:          time.Minute,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Start - starts relay in background, it is stopped by closer
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	closer.Add(r.Stop)
+
+	go func() {
+		defer close(r.done)
+		r.Run(ctx)
+	}()
+}
+
+// Stop - stops relay and waits for batches in progress
+func (r *Relay) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("outbox: stop: %w", ctx.Err())
+	}
+}
+
+// Run - publishes messages of all partitions until ctx is done
+func (r *Relay) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < r.partitions; i++ {
+		wg.Add(1)
+		go func(partition Partition) {
+			defer wg.Done()
+			r.runPartition(ctx, partition)
+		}(Partition{Index: i, Count: r.partitions})
+	}
+	wg.Wait()
+}
+
+func (r *Relay) runPartition(ctx context.Context, partition Partition) {
+	ctx = logger.WithFields(ctx, zap.Int("partition", partition.Index))
+
+	for {
+		// taken before fetch so that notification during publishing is not lost
+		wakeup := r.wakeup()
+
+		n, err := r.PublishBatch(ctx, partition)
+		if err != nil && ctx.Err() == nil {
+			logger.ErrorKV(ctx, "outbox: publish batch", "error", err.Error())
+		}
+
+		if n > 0 && err == nil {
+			continue // published messages might unblock next messages of their aggregates
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wakeup:
+		case <-time.After(r.idleInterval()):
+		}
+	}
+}
+
+// wakeup - returns channel closed on new messages, nil channel without waker blocks forever
+func (r *Relay) wakeup() <-chan struct{} {
+	if r.waker == nil {
+		return nil
+	}
+	return r.waker.C()
+}
+
+// idleInterval - pause between polls when there are no due messages
+func (r *Relay) idleInterval() time.Duration {
+	if r.waker != nil && r.waker.Listening() {
+		return r.listenInterval
+	}
+	return r.pollInterval
+}
+
+// PublishBatch - claims due messages of partition, publishes them and saves their delivery state,
+// returns number of processed messages
+func (r *Relay) PublishBatch(ctx context.Context, partition Partition) (int, error) {
+	msgs, err := r.claim(ctx, partition)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		processed int
+		errs      []error
+	)
+	for i := range msgs {
+		r.deliver(ctx, &msgs[i])
+
+		// message which state is not saved is published again after its lease expires
+		if err = r.storage.UpdateDelivery(ctx, &msgs[i]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		processed++
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// claim - returns due messages of partition leased till now + lease, so that they are not fetched
+// by other relays and the following messages of their aggregates are held back while they are published
+func (r *Relay) claim(ctx context.Context, partition Partition) ([]Message, error) {
+	var msgs []Message
+	err := r.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			var err error
+			if msgs, err = r.storage.FetchPending(txCtx, partition, time.Now(), r.batchSize); err != nil {
+				return err
+			}
+
+			leasedUntil := time.Now().Add(r.lease)
+			for i := range msgs {
+				leased := msgs[i]
+				leased.NextAttemptAt = leasedUntil
+				if err = r.storage.UpdateDelivery(txCtx, &leased); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// deliver - publishes message and updates its delivery state
+func (r *Relay) deliver(ctx context.Context, msg *Message) {
+	msg.Attempts++
+
+	// publishing continues the trace of the request which saved the message
+	ctx, span := tracing.Start(tracing.ContextWithTraceContext(ctx, msg.TraceParent, msg.TraceState),
+		"outbox.Publish "+msg.EventType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.Int64("outbox.message_id", msg.ID),
+			attribute.String("outbox.aggregate_id", msg.AggregateID),
+			attribute.Int64("outbox.sequence", msg.Sequence),
+			attribute.Int("outbox.attempt", msg.Attempts),
+		),
+	)
+	defer span.End()
+
+	err := r.publish(ctx, *msg)
+	tracing.RecordError(span, err)
+	if err == nil {
+		msg.Status = StatusSent
+		msg.SentAt = time.Now()
+		msg.LastError = ""
+		return
+	}
+
+	msg.LastError = err.Error()
+	if msg.Attempts >= r.maxAttempts {
+		msg.Status = StatusDead
+		logger.ErrorKV(ctx, "outbox: message is dead",
+			"id", msg.ID, "aggregate_id", msg.AggregateID, "sequence", msg.Sequence,
+			"event_type", msg.EventType, "attempts", msg.Attempts, "error", msg.LastError)
+		return
+	}
+
+	msg.NextAttemptAt = time.Now().Add(r.backoff(msg.Attempts))
+	logger.WarnKV(ctx, "outbox: publish failed",
+		"id", msg.ID, "aggregate_id", msg.AggregateID, "sequence", msg.Sequence,
+		"event_type", msg.EventType, "attempts", msg.Attempts, "error", msg.LastError)
+}
+
+func (r *Relay) publish(ctx context.Context, msg Message) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic recovered: %v", rec)
+		}
+	}()
+
+	return r.publisher.Publish(ctx, msg)
+}
+
+// backoff - delay before next attempt: initial, initial*2, initial*4, ... up to max
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.initialBackoff
+	for i := 1; i < attempts; i++ {
+		if d *= 2; d >= r.maxBackoff {
+			return r.maxBackoff
+		}
+	}
+	return d
+}
@@ -0,0 +1,491 @@
+//go:build test
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeStorage - in-memory outbox table, transactions are not isolated
+type fakeStorage struct {
+	mu       sync.Mutex
+	messages map[int64]Message
+	inTx     bool
+}
+
+func newFakeStorage(msgs ...Message) *fakeStorage {
+	s := &fakeStorage{messages: make(map[int64]Message, len(msgs))}
+	for _, msg := range msgs {
+		s.messages[msg.ID] = msg
+	}
+	return s
+}
+
+func (s *fakeStorage) FetchPending(_ context.Context, partition Partition, now time.Time, limit uint64) ([]Message, error) {
+	return s.filter(func(msg Message) bool {
+		if msg.Status != StatusPending || msg.NextAttemptAt.After(now) {
+			return false
+		}
+
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(msg.AggregateID))
+		if int(h.Sum32())%partition.Count != partition.Index {
+			return false
+		}
+
+		for _, prev := range s.messages {
+			if prev.AggregateID == msg.AggregateID && prev.Sequence < msg.Sequence && prev.Status != StatusSent {
+				return false
+			}
+		}
+		return true
+	}, limit), nil
+}
+
+func (s *fakeStorage) ListDead(_ context.Context, afterID int64, limit uint64) ([]Message, error) {
+	return s.filter(func(msg Message) bool {
+		return msg.Status == StatusDead && msg.ID > afterID
+	}, limit), nil
+}
+
+func (s *fakeStorage) GetMessage(_ context.Context, id int64) (*Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msg, ok := s.messages[id]
+	if !ok {
+		return nil, models.ErrNotFound
+	}
+	return &msg, nil
+}
+
+func (s *fakeStorage) GetMessageForUpdate(ctx context.Context, id int64) (*Message, error) {
+	return s.GetMessage(ctx, id)
+}
+
+func (s *fakeStorage) UpdateDelivery(_ context.Context, msg *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.messages[msg.ID]; !ok {
+		return models.ErrNotFound
+	}
+	s.messages[msg.ID] = *msg
+	return nil
+}
+
+// Add - saves message as if it was committed by business transaction
+func (s *fakeStorage) Add(msg Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[msg.ID] = msg
+}
+
+func (s *fakeStorage) RunReadCommitted(ctx context.Context, _ pgx.TxAccessMode, f func(ctx context.Context) error) error {
+	s.setInTx(true)
+	defer s.setInTx(false)
+
+	return f(ctx)
+}
+
+func (s *fakeStorage) setInTx(inTx bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inTx = inTx
+}
+
+func (s *fakeStorage) filter(match func(msg Message) bool, limit uint64) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var res []Message
+	for _, msg := range s.messages {
+		if match(msg) {
+			res = append(res, msg)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if uint64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+type publisherFunc func(ctx context.Context, msg Message) error
+
+func (f publisherFunc) Publish(ctx context.Context, msg Message) error {
+	return f(ctx, msg)
+}
+
+func TestRelay_PublishBatch(t *testing.T) {
+	var (
+		past       = time.Now().Add(-time.Minute)
+		future     = time.Now().Add(time.Hour)
+		errPublish = errors.New("broker is unavailable")
+	)
+
+	tests := []struct {
+		name      string
+		messages  []Message
+		publish   func(msg Message) error
+		want      int
+		check     func(t *testing.T, s *fakeStorage)
+		published []int64
+	}{
+		{
+			name: "Test 1. Due messages are published in id order.",
+			messages: []Message{
+				{ID: 2, AggregateID: "2", Status: StatusPending, NextAttemptAt: past},
+				{ID: 1, AggregateID: "1", Status: StatusPending, NextAttemptAt: past},
+				{ID: 3, AggregateID: "3", Status: StatusPending, NextAttemptAt: future},
+				{ID: 4, AggregateID: "4", Status: StatusDead, NextAttemptAt: past},
+			},
+			publish:   func(Message) error { return nil },
+			want:      2,
+			published: []int64{1, 2},
+			check: func(t *testing.T, s *fakeStorage) {
+				msg := s.messages[1]
+				assert.Equal(t, StatusSent, msg.Status)
+				assert.Equal(t, 1, msg.Attempts)
+				assert.False(t, msg.SentAt.IsZero())
+
+				assert.Equal(t, StatusPending, s.messages[3].Status)
+				assert.Equal(t, StatusDead, s.messages[4].Status)
+			},
+		},
+		{
+			name: "Test 4. Message waits for previous messages of its aggregate.",
+			messages: []Message{
+				{ID: 1, AggregateID: "1", Sequence: 1, Status: StatusPending, NextAttemptAt: future},
+				{ID: 2, AggregateID: "1", Sequence: 2, Status: StatusPending, NextAttemptAt: past},
+				{ID: 3, AggregateID: "2", Sequence: 1, Status: StatusDead, NextAttemptAt: past},
+				{ID: 4, AggregateID: "2", Sequence: 2, Status: StatusPending, NextAttemptAt: past},
+				{ID: 5, AggregateID: "3", Sequence: 1, Status: StatusSent, NextAttemptAt: past},
+				{ID: 6, AggregateID: "3", Sequence: 2, Status: StatusPending, NextAttemptAt: past},
+			},
+			publish:   func(Message) error { return nil },
+			want:      1,
+			published: []int64{6},
+			check: func(t *testing.T, s *fakeStorage) {
+				assert.Equal(t, StatusPending, s.messages[2].Status)
+				assert.Equal(t, StatusPending, s.messages[4].Status)
+			},
+		},
+		{
+			name: "Test 2. Failed message is retried with exponential backoff.",
+			messages: []Message{
+				{ID: 1, Status: StatusPending, Attempts: 2, NextAttemptAt: past},
+			},
+			publish:   func(Message) error { return errPublish },
+			want:      1,
+			published: []int64{1},
+			check: func(t *testing.T, s *fakeStorage) {
+				msg := s.messages[1]
+				assert.Equal(t, StatusPending, msg.Status)
+				assert.Equal(t, 3, msg.Attempts)
+				assert.Equal(t, errPublish.Error(), msg.LastError)
+				// third attempt failed: 1s * 2 * 2
+				assert.WithinDuration(t, time.Now().Add(4*time.Second), msg.NextAttemptAt, time.Second)
+			},
+		},
+		{
+			name: "Test 3. Message becomes dead after max attempts.",
+			messages: []Message{
+				{ID: 1, Status: StatusPending, Attempts: 4, NextAttemptAt: past},
+			},
+			publish:   func(Message) error { panic("unexpected") },
+			want:      1,
+			published: []int64{1},
+			check: func(t *testing.T, s *fakeStorage) {
+				msg := s.messages[1]
+				assert.Equal(t, StatusDead, msg.Status)
+				assert.Equal(t, 5, msg.Attempts)
+				assert.Equal(t, "panic recovered: unexpected", msg.LastError)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				storage   = newFakeStorage(tt.messages...)
+				published []int64
+			)
+
+			relay := NewRelay(storage, storage, publisherFunc(func(_ context.Context, msg Message) error {
+				published = append(published, msg.ID)
+				return tt.publish(msg)
+			}), WithMaxAttempts(5), WithBackoff(time.Second, time.Minute))
+
+			got, err := relay.PublishBatch(ctx, Partition{Index: 0, Count: 1})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.published, published)
+			tt.check(t, storage)
+		})
+	}
+}
+
+func TestRelay_PublishBatch_traceContext(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		storage = newFakeStorage(Message{
+			ID:          1,
+			AggregateID: "1",
+			Status:      StatusPending,
+			TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			TraceState:  "vendor=opaque",
+		})
+		got trace.SpanContext
+	)
+
+	relay := NewRelay(storage, storage, publisherFunc(func(ctx context.Context, msg Message) error {
+		got = trace.SpanContextFromContext(ctx)
+		return nil
+	}))
+
+	_, err := relay.PublishBatch(ctx, Partition{Index: 0, Count: 1})
+	require.NoError(t, err)
+
+	// publishing continues the trace of the request which saved the message
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", got.TraceID().String())
+	assert.True(t, got.IsSampled())
+	assert.Equal(t, "vendor=opaque", got.TraceState().String())
+}
+
+func TestRelay_PublishBatch_lease(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		storage = newFakeStorage(Message{ID: 1, AggregateID: "1", Status: StatusPending})
+		claimed Message
+		inTx    bool
+	)
+
+	relay := NewRelay(storage, storage, publisherFunc(func(context.Context, Message) error {
+		storage.mu.Lock()
+		defer storage.mu.Unlock()
+
+		claimed, inTx = storage.messages[1], storage.inTx
+		return nil
+	}), WithLease(time.Minute))
+
+	got, err := relay.PublishBatch(ctx, Partition{Index: 0, Count: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	// message is published outside of transaction while it is leased
+	assert.False(t, inTx)
+	assert.Equal(t, StatusPending, claimed.Status)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), claimed.NextAttemptAt, time.Second)
+
+	n, err := relay.PublishBatch(ctx, Partition{Index: 0, Count: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, StatusSent, storage.messages[1].Status)
+}
+
+func TestRelay_Run(t *testing.T) {
+	const (
+		aggregates = 10
+		events     = 5
+	)
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		messages    []Message
+	)
+	defer cancel()
+
+	for seq := 1; seq <= events; seq++ {
+		for agg := 0; agg < aggregates; agg++ {
+			messages = append(messages, Message{
+				ID:          int64(len(messages) + 1),
+				AggregateID: fmt.Sprintf("aggregate-%d", agg),
+				Sequence:    int64(seq),
+				Status:      StatusPending,
+			})
+		}
+	}
+
+	var (
+		storage   = newFakeStorage(messages...)
+		mu        sync.Mutex
+		published = make(map[string][]int64)
+		failed    = make(map[int64]bool)
+	)
+
+	relay := NewRelay(storage, storage, publisherFunc(func(_ context.Context, msg Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// every third message fails once
+		if msg.ID%3 == 0 && !failed[msg.ID] {
+			failed[msg.ID] = true
+			return errors.New("some error")
+		}
+		published[msg.AggregateID] = append(published[msg.AggregateID], msg.Sequence)
+		return nil
+	}), WithPartitions(3), WithPollInterval(time.Millisecond), WithBackoff(time.Millisecond, time.Millisecond))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool {
+		pending, _ := storage.FetchPending(ctx, Partition{Index: 0, Count: 1}, time.Now().Add(time.Hour), 1)
+		return len(pending) == 0
+	}, 5*time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	want := []int64{1, 2, 3, 4, 5}
+	for agg := 0; agg < aggregates; agg++ {
+		assert.Equal(t, want, published[fmt.Sprintf("aggregate-%d", agg)])
+	}
+}
+
+func TestRelay_backoff(t *testing.T) {
+	relay := NewRelay(nil, nil, nil, WithBackoff(time.Second, 10*time.Second))
+
+	var got []time.Duration
+	for attempts := 1; attempts <= 6; attempts++ {
+		got = append(got, relay.backoff(attempts))
+	}
+
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second,
+	}, got)
+}
+
+func TestAdmin_Requeue(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		wantErr error
+	}{
+		{
+			name: "Test 1. Dead message is requeued.",
+			id:   1,
+		},
+		{
+			name:    "Test 2. Pending message can not be requeued.",
+			id:      2,
+			wantErr: models.ErrInvalidOutboxMessageStatus,
+		},
+		{
+			name:    "Test 3. Unknown message.",
+			id:      3,
+			wantErr: models.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				storage = newFakeStorage(
+					Message{ID: 1, Status: StatusDead, Attempts: 10, LastError: "some error"},
+					Message{ID: 2, Status: StatusPending, Attempts: 1},
+				)
+				admin = NewAdmin(storage, storage)
+			)
+
+			got, err := admin.Requeue(ctx, tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, StatusPending, got.Status)
+			assert.Equal(t, 0, got.Attempts)
+			assert.Equal(t, "some error", got.LastError)
+			assert.Equal(t, *got, storage.messages[tt.id])
+
+			dead, err := admin.ListDead(ctx, 0, 10)
+			require.NoError(t, err)
+			assert.Empty(t, dead)
+		})
+	}
+}
+
+type fakeWaker struct {
+	mu     sync.Mutex
+	wakeup chan struct{}
+}
+
+func (w *fakeWaker) C() <-chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.wakeup
+}
+
+func (w *fakeWaker) Listening() bool {
+	return true
+}
+
+func (w *fakeWaker) Wake() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	close(w.wakeup)
+	w.wakeup = make(chan struct{})
+}
+
+func TestRelay_Run_wakeup(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		storage     = newFakeStorage()
+		waker       = &fakeWaker{wakeup: make(chan struct{})}
+		published   = make(chan int64, 1)
+	)
+	defer cancel()
+
+	relay := NewRelay(storage, storage, publisherFunc(func(_ context.Context, msg Message) error {
+		published <- msg.ID
+		return nil
+	}), WithPartitions(1), WithPollInterval(time.Millisecond), WithWaker(waker, time.Hour))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	// relay is idle waiting for wake-up, poll interval is not used while waker is listening
+	time.Sleep(50 * time.Millisecond)
+	storage.Add(Message{ID: 1, AggregateID: "1", Status: StatusPending})
+	select {
+	case id := <-published:
+		t.Fatalf("message %d is published without wake-up", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	waker.Wake()
+	select {
+	case id := <-published:
+		assert.Equal(t, int64(1), id)
+	case <-time.After(time.Second):
+		t.Fatal("message is not published after wake-up")
+	}
+
+	cancel()
+	<-done
+}
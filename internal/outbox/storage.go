@@ -0,0 +1,28 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type (
+	// Storage - outbox table
+	Storage interface {
+		// FetchPending - returns pending messages of partition due at now and locks them till the end of transaction.
+		// A message is returned only when all previous messages of its aggregate are sent,
+		// messages locked by other relays are skipped
+		FetchPending(ctx context.Context, partition Partition, now time.Time, limit uint64) ([]Message, error)
+		// ListDead - returns dead messages with id greater than afterID ordered by id
+		ListDead(ctx context.Context, afterID int64, limit uint64) ([]Message, error)
+		GetMessage(ctx context.Context, id int64) (*Message, error)
+		GetMessageForUpdate(ctx context.Context, id int64) (*Message, error)
+		// UpdateDelivery - saves status, attempts, last error, next attempt and sent time of message
+		UpdateDelivery(ctx context.Context, msg *Message) error
+	}
+
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+	}
+)
@@ -0,0 +1,129 @@
+// Package projections - rebuild of orders projection from order_events log,
+// e.g. after projection schema change or to repair drifted rows
+package projections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+type (
+	// Storage - order_events log and orders projection
+	Storage interface {
+		// ListEventStreams - returns ids of orders having events greater than afterID in ascending order
+		ListEventStreams(ctx context.Context, afterID models.OrderID, limit uint64) ([]models.OrderID, error)
+		// ListOrderEvents - returns stream of the order in order of versions
+		ListOrderEvents(ctx context.Context, orderID models.OrderID) ([]models.OrderDomainEvent, error)
+		// GetOrderForUpdate - returns projection of the order and locks it till the end of transaction
+		GetOrderForUpdate(ctx context.Context, id models.OrderID) (*models.Order, error)
+		// SaveOrderProjection - inserts or overwrites row of the order in orders projection
+		SaveOrderProjection(ctx context.Context, order *models.Order) error
+	}
+
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+	}
+)
+
+const defaultBatchSize = 100
+
+// Rebuilder - replays order streams into orders projection
+type Rebuilder struct {
+	storage   Storage
+	txManager TransactionManager
+	batchSize uint64
+}
+
+// Option - rebuilder option
+type Option func(r *Rebuilder)
+
+// WithBatchSize - number of order ids read from log at once
+func WithBatchSize(n uint64) Option {
+	return func(r *Rebuilder) {
+		if n > 0 {
+			r.batchSize = n
+		}
+	}
+}
+
+// NewRebuilder - returns rebuilder
+func NewRebuilder(storage Storage, txManager TransactionManager, opts ...Option) *Rebuilder {
+	r := &Rebuilder{
+		storage:   storage,
+		txManager: txManager,
+		batchSize: defaultBatchSize,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Rebuild - rebuilds projections of all orders having events, returns number of rebuilt orders.
+// Orders failed to rebuild are skipped and reported in error, so that one broken stream does not block others
+func (r *Rebuilder) Rebuild(ctx context.Context) (int, error) {
+	var (
+		rebuilt int
+		errs    []error
+		afterID models.OrderID
+	)
+
+	for {
+		ids, err := r.storage.ListEventStreams(ctx, afterID, r.batchSize)
+		if err != nil {
+			return rebuilt, errors.Join(append(errs, err)...)
+		}
+
+		for _, id := range ids {
+			if err = ctx.Err(); err != nil {
+				return rebuilt, errors.Join(append(errs, err)...)
+			}
+
+			if err = r.RebuildOrder(ctx, id); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			rebuilt++
+		}
+
+		if uint64(len(ids)) < r.batchSize {
+			return rebuilt, errors.Join(errs...)
+		}
+		afterID = ids[len(ids)-1]
+	}
+}
+
+// RebuildOrder - replays stream of the order and overwrites its projection.
+// Projection row is locked while stream is read, so that service may keep changing orders during rebuild
+func (r *Rebuilder) RebuildOrder(ctx context.Context, id models.OrderID) error {
+	err := r.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			// projection might be lost, then there is nothing to lock: new events can't be appended without it
+			if _, err := r.storage.GetOrderForUpdate(txCtx, id); err != nil && !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+
+			events, err := r.storage.ListOrderEvents(txCtx, id)
+			if err != nil {
+				return err
+			}
+
+			order, err := models.ReplayOrder(events)
+			if err != nil {
+				return err
+			}
+
+			return r.storage.SaveOrderProjection(txCtx, order)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("projections: rebuild order %s: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+//go:build test
+
+package projections
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStorage - event streams in memory, ids are listed in order of streams
+type fakeStorage struct {
+	ids         []models.OrderID
+	streams     map[models.OrderID][]models.OrderDomainEvent
+	projections map[models.OrderID]*models.Order
+}
+
+func (s *fakeStorage) ListEventStreams(_ context.Context, afterID models.OrderID, limit uint64) ([]models.OrderID, error) {
+	start := 0
+	for i, id := range s.ids {
+		if id == afterID {
+			start = i + 1
+		}
+	}
+	end := min(start+int(limit), len(s.ids))
+	return s.ids[start:end], nil
+}
+
+func (s *fakeStorage) ListOrderEvents(_ context.Context, orderID models.OrderID) ([]models.OrderDomainEvent, error) {
+	return s.streams[orderID], nil
+}
+
+func (s *fakeStorage) GetOrderForUpdate(_ context.Context, id models.OrderID) (*models.Order, error) {
+	if order, ok := s.projections[id]; ok {
+		return order, nil
+	}
+	return nil, models.ErrNotFound
+}
+
+func (s *fakeStorage) SaveOrderProjection(_ context.Context, order *models.Order) error {
+	s.projections[order.ID] = order
+	return nil
+}
+
+type fakeTransactionManager struct{}
+
+func (fakeTransactionManager) RunReadCommitted(ctx context.Context, _ pgx.TxAccessMode, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func TestRebuilder_Rebuild(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		paidID  = models.OrderID(uuid.New())
+		lostID  = models.OrderID(uuid.New())
+		brokeID = models.OrderID(uuid.New())
+	)
+	created := func(id models.OrderID) models.OrderDomainEvent {
+		return models.NewOrderDomainEvent(&models.Order{ID: id}, models.OrderCreatedData{Order: models.Order{
+			ID:     id,
+			UserID: 1,
+			Status: models.OrderStatusAwaitingPayment,
+		}})
+	}
+	paid := models.NewOrderDomainEvent(&models.Order{ID: paidID, Version: 1}, models.OrderPaidData{PaymentID: "pi_1"})
+
+	storage := &fakeStorage{
+		ids: []models.OrderID{paidID, lostID, brokeID},
+		streams: map[models.OrderID][]models.OrderDomainEvent{
+			paidID:  {created(paidID), paid},
+			lostID:  {created(lostID)},
+			brokeID: {paid},
+		},
+		projections: map[models.OrderID]*models.Order{
+			// drifted projection: payment is lost
+			paidID: {ID: paidID, Version: 1, UserID: 1, Status: models.OrderStatusAwaitingPayment},
+		},
+	}
+
+	rebuilt, err := NewRebuilder(storage, fakeTransactionManager{}, WithBatchSize(2)).Rebuild(ctx)
+
+	assert.ErrorIs(t, err, models.ErrInvalidOrderEvents)
+	assert.Equal(t, 2, rebuilt)
+
+	require.Contains(t, storage.projections, paidID)
+	assert.Equal(t, models.OrderStatusPaid, storage.projections[paidID].Status)
+	assert.Equal(t, models.PaymentID("pi_1"), storage.projections[paidID].PaymentID)
+	assert.Equal(t, int64(2), storage.projections[paidID].Version)
+
+	require.Contains(t, storage.projections, lostID, "lost projection is restored")
+	assert.Equal(t, models.OrderStatusAwaitingPayment, storage.projections[lostID].Status)
+
+	assert.NotContains(t, storage.projections, brokeID)
+}
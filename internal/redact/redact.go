@@ -0,0 +1,108 @@
+// Package redact - masking of fields marked with (sensitive) = true option
+// before messages reach logs, spans or audit records
+package redact
+
+import (
+	"sync"
+
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mask - replacement of non-empty sensitive string
+const Mask = "[REDACTED]"
+
+// Message - returns copy of msg with sensitive fields masked: strings are replaced with Mask,
+// other fields are cleared. msg itself is returned when it has no sensitive fields.
+func Message(msg proto.Message) proto.Message {
+	if msg == nil || !hasSensitive(msg.ProtoReflect().Descriptor()) {
+		return msg
+	}
+
+	res := proto.Clone(msg)
+	redact(res.ProtoReflect())
+	return res
+}
+
+// JSON - returns JSON of msg with sensitive fields masked
+func JSON(msg proto.Message) string {
+	b, err := protojson.Marshal(Message(msg))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case IsSensitive(fd):
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() && v.String() != "" {
+				m.Set(fd, protoreflect.ValueOfString(Mask))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if isMessage(fd.MapValue()) && hasSensitive(fd.MapValue().Message()) {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redact(v.Message())
+					return true
+				})
+			}
+		case isMessage(fd) && hasSensitive(fd.Message()):
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					redact(v.List().Get(i).Message())
+				}
+			} else {
+				redact(v.Message())
+			}
+		}
+		return true
+	})
+}
+
+// IsSensitive - reports whether field is marked with (sensitive) = true option
+func IsSensitive(fd protoreflect.FieldDescriptor) bool {
+	sensitive, _ := proto.GetExtension(fd.Options(), pb.E_Sensitive).(bool)
+	return sensitive
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// cache - whether message type has sensitive fields at any depth, by full name
+var cache sync.Map
+
+func hasSensitive(md protoreflect.MessageDescriptor) bool {
+	if v, ok := cache.Load(md.FullName()); ok {
+		return v.(bool)
+	}
+
+	res := scan(md, map[protoreflect.FullName]bool{})
+	cache.Store(md.FullName(), res)
+	return res
+}
+
+// scan - visited breaks recursion of self-referencing types
+func scan(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if IsSensitive(fields.Get(i)) || (isMessage(fd) && scan(fd.Message(), visited)) {
+			return true
+		}
+	}
+	return false
+}
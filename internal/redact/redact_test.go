@@ -0,0 +1,67 @@
+//go:build test
+
+package redact
+
+import (
+	"testing"
+
+	pb "github.com/moguchev/microservices_courcse/orders_management_system/pkg/api/orders_management_system"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want proto.Message
+	}{
+		{
+			name: "Test 1. Nested sensitive field is masked.",
+			msg: &pb.CreateOrderResponse{
+				OrderId: "order",
+				Payment: &pb.CreateOrderResponse_Payment{
+					PaymentId:       "payment",
+					ConfirmationUrl: "https://pay.example.com/?token=secret",
+				},
+			},
+			want: &pb.CreateOrderResponse{
+				OrderId: "order",
+				Payment: &pb.CreateOrderResponse_Payment{
+					PaymentId:       "payment",
+					ConfirmationUrl: Mask,
+				},
+			},
+		},
+		{
+			name: "Test 2. Sensitive fields in lists are masked.",
+			msg: &pb.ListWebhooksResponse{
+				Subscriptions: []*pb.WebhookSubscription{
+					{Id: "1", Url: "https://hooks.example.com/?key=secret"},
+					{Id: "2"},
+				},
+			},
+			want: &pb.ListWebhooksResponse{
+				Subscriptions: []*pb.WebhookSubscription{
+					{Id: "1", Url: Mask},
+					{Id: "2"},
+				},
+			},
+		},
+		{
+			name: "Test 3. Message without sensitive fields is returned as is.",
+			msg:  &pb.ConfirmPaymentRequest{OrderId: "order", PaymentId: "payment"},
+			want: &pb.ConfirmPaymentRequest{OrderId: "order", PaymentId: "payment"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := proto.Clone(tt.msg)
+
+			got := Message(tt.msg)
+
+			assert.True(t, proto.Equal(tt.want, got), "got %v", got)
+			assert.True(t, proto.Equal(original, tt.msg), "original message is modified")
+		})
+	}
+}
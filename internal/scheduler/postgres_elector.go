@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+)
+
+// ConnectionPool - source of dedicated connections, session advisory lock lives as long as connection
+type ConnectionPool interface {
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// PostgresElector - leader election on postgres session advisory lock:
+// replica holding the lock is the leader, lock is released on unlock or when connection is lost
+type PostgresElector struct {
+	pool          ConnectionPool
+	key           int64
+	retryInterval time.Duration
+	checkInterval time.Duration
+}
+
+// Check that we implemet contract for scheduler
+var _ Elector = (*PostgresElector)(nil)
+
+// NewPostgresElector - returns elector, replicas with the same name compete for the same lock
+func NewPostgresElector(pool ConnectionPool, name string) *PostgresElector {
+	return &PostgresElector{
+		pool:          pool,
+		key:           LockKey(name),
+		retryInterval: 5 * time.Second,
+		checkInterval: 5 * time.Second,
+	}
+}
+
+// LockKey - advisory lock key of the name
+func LockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+func (e *PostgresElector) Campaign(ctx context.Context) (context.Context, error) {
+	for {
+		conn, acquired, err := e.tryLock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			leaderCtx, cancel := context.WithCancel(ctx)
+			go e.hold(leaderCtx, cancel, conn)
+			return leaderCtx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
+
+func (e *PostgresElector) tryLock(ctx context.Context) (*pgxpool.Conn, bool, error) {
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("scheduler: acquire connection: %w", err)
+	}
+
+	var acquired bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired); err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("scheduler: try advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	return conn, true, nil
+}
+
+// hold - keeps the lock until ctx is done, cancels leadership when connection is broken
+func (e *PostgresElector) hold(ctx context.Context, cancel context.CancelFunc, conn *pgxpool.Conn) {
+	defer cancel()
+
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			unlockCtx, unlockCancel := context.WithTimeout(context.Background(), e.checkInterval)
+			defer unlockCancel()
+
+			if _, err := conn.Exec(unlockCtx, "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+				// lock is released together with the session
+				_ = conn.Conn().Close(unlockCtx)
+			}
+			conn.Release()
+			return
+		case <-ticker.C:
+			if err := conn.Ping(ctx); err != nil && ctx.Err() == nil {
+				logger.ErrorKV(ctx, "scheduler: leader connection is broken", "error", err.Error())
+				_ = conn.Conn().Close(context.Background())
+				conn.Release()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Package scheduler - runs periodic jobs on cron-like schedules, only on the leader replica
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+// JobFunc - job body, ctx is cancelled on shutdown or when leadership is lost
+type JobFunc func(ctx context.Context) error
+
+// Elector - leader election, jobs run only while the replica is the leader
+type Elector interface {
+	// Campaign - blocks until leadership is acquired or ctx is done,
+	// returned context is cancelled when leadership is lost
+	Campaign(ctx context.Context) (context.Context, error)
+}
+
+type job struct {
+	name     string
+	spec     string
+	schedule cron.Schedule
+	fn       JobFunc
+}
+
+// specParser - standard 5 fields cron expressions and descriptors (@hourly, @every 30s, ...)
+var specParser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Scheduler - runs registered jobs, each job runs once cluster-wide thanks to leader election
+type Scheduler struct {
+	elector       Elector
+	retryInterval time.Duration
+
+	mu   sync.Mutex
+	jobs []*job
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Option - scheduler option
+type Option func(s *Scheduler)
+
+// WithRetryInterval - pause between failed leadership campaigns
+func WithRetryInterval(d time.Duration) Option {
+	return func(s *Scheduler) {
+		s.retryInterval = d
+	}
+}
+
+// New - returns scheduler
+func New(elector Elector, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		elector:       elector,
+		retryInterval: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register - adds job with schedule spec, e.g. "*/5 * * * *" or "@every 30s"
+func (s *Scheduler) Register(name, spec string, fn JobFunc) error {
+	schedule, err := specParser.Parse(spec)
+	if err != nil {
+		return fmt.Errorf("scheduler: job %q: invalid schedule %q: %w", name, spec, err)
+	}
+
+	return s.register(&job{
+		name:     name,
+		spec:     spec,
+		schedule: schedule,
+		fn:       fn,
+	})
+}
+
+func (s *Scheduler) register(newJob *job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range s.jobs {
+		if j.name == newJob.name {
+			return fmt.Errorf("scheduler: job %q is already registered", newJob.name)
+		}
+	}
+
+	s.jobs = append(s.jobs, newJob)
+
+	return nil
+}
+
+// Start - starts scheduler in background, it is stopped by closer
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	closer.Add(s.Stop)
+
+	go func() {
+		defer close(s.done)
+		s.run(ctx)
+	}()
+}
+
+// Stop - stops scheduler and waits for running jobs
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("scheduler: stop: %w", ctx.Err())
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	for {
+		leaderCtx, err := s.elector.Campaign(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.ErrorKV(ctx, "scheduler: leader election failed", "error", err.Error())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.retryInterval):
+				continue
+			}
+		}
+
+		logger.Info(ctx, "scheduler: became leader, starting jobs")
+		s.runJobs(leaderCtx)
+
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn(ctx, "scheduler: leadership lost, jobs stopped")
+	}
+}
+
+// runJobs - runs all jobs until ctx is done
+func (s *Scheduler) runJobs(ctx context.Context) {
+	s.mu.Lock()
+	jobs := append([]*job(nil), s.jobs...)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func(j *job) {
+			defer wg.Done()
+			s.runJob(ctx, j)
+		}(j)
+	}
+	wg.Wait()
+}
+
+// runJob - runs job on its schedule, runs never overlap
+func (s *Scheduler) runJob(ctx context.Context, j *job) {
+	ctx = logger.WithFields(ctx, zap.String("job", j.name), zap.String("schedule", j.spec))
+
+	for {
+		next := j.schedule.Next(time.Now())
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		started := time.Now()
+		if err := s.execute(ctx, j); err != nil && !errors.Is(err, context.Canceled) {
+			logger.ErrorKV(ctx, "scheduler: job failed", "error", err.Error(), "duration", time.Since(started).String())
+			continue
+		}
+		logger.DebugKV(ctx, "scheduler: job finished", "duration", time.Since(started).String())
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic recovered: %v", r)
+		}
+	}()
+
+	return j.fn(ctx)
+}
@@ -0,0 +1,121 @@
+//go:build test
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// leaderElector - elector which is always the leader until lose is called
+type leaderElector struct {
+	campaigns atomic.Int32
+	cancel    atomic.Pointer[context.CancelFunc]
+}
+
+func (e *leaderElector) Campaign(ctx context.Context) (context.Context, error) {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.cancel.Store(&cancel)
+	e.campaigns.Add(1)
+	return leaderCtx, nil
+}
+
+func (e *leaderElector) lose() {
+	(*e.cancel.Load())()
+}
+
+// every - sub-second schedule, cron descriptors are rounded up to seconds
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+func registerEvery(t *testing.T, s *Scheduler, name string, fn JobFunc) {
+	require.NoError(t, s.register(&job{
+		name:     name,
+		spec:     "@every 10ms",
+		schedule: every(10 * time.Millisecond),
+		fn:       fn,
+	}))
+}
+
+// followerElector - elector which never becomes the leader
+type followerElector struct{}
+
+func (followerElector) Campaign(ctx context.Context) (context.Context, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestScheduler_Register(t *testing.T) {
+	s := New(followerElector{})
+
+	require.NoError(t, s.Register("job", "*/5 * * * *", func(context.Context) error { return nil }))
+	assert.Error(t, s.Register("job", "@every 1s", func(context.Context) error { return nil }), "duplicate name")
+	assert.Error(t, s.Register("other", "every minute", func(context.Context) error { return nil }), "invalid spec")
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Run("Test 1. Leader runs jobs, failed and panicked runs don't stop the job.", func(t *testing.T) {
+		var runs, failures atomic.Int32
+
+		s := New(&leaderElector{})
+		registerEvery(t, s, "ok", func(context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+		registerEvery(t, s, "failing", func(context.Context) error {
+			if failures.Add(1)%2 == 0 {
+				panic("boom")
+			}
+			return errors.New("some error")
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Start(ctx)
+
+		assert.Eventually(t, func() bool {
+			return runs.Load() >= 3 && failures.Load() >= 3
+		}, time.Second, 5*time.Millisecond)
+
+		require.NoError(t, s.Stop(context.Background()))
+	})
+
+	t.Run("Test 2. Follower doesn't run jobs.", func(t *testing.T) {
+		var runs atomic.Int32
+
+		s := New(followerElector{})
+		registerEvery(t, s, "ok", func(context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		s.Start(context.Background())
+		time.Sleep(50 * time.Millisecond)
+		require.NoError(t, s.Stop(context.Background()))
+
+		assert.Zero(t, runs.Load())
+	})
+
+	t.Run("Test 3. Scheduler campaigns again when leadership is lost.", func(t *testing.T) {
+		elector := &leaderElector{}
+		s := New(elector)
+		registerEvery(t, s, "ok", func(context.Context) error { return nil })
+
+		s.Start(context.Background())
+		assert.Eventually(t, func() bool { return elector.campaigns.Load() == 1 }, time.Second, 5*time.Millisecond)
+
+		elector.lose()
+		assert.Eventually(t, func() bool { return elector.campaigns.Load() == 2 }, time.Second, 5*time.Millisecond)
+
+		require.NoError(t, s.Stop(context.Background()))
+	})
+}
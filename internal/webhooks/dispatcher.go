@@ -0,0 +1,263 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/logger"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/transaction_manager"
+)
+
+const contentType = "application/cloudevents+json"
+
+// Dispatcher - POSTs signed deliveries to subscriptions, failed deliveries are retried with exponential backoff
+// and become dead after max attempts. Deliveries to one subscription are not ordered between retries.
+// Deliveries are claimed for a lease in a short transaction and sent outside of it,
+// delivery of crashed dispatcher is sent again after its lease expires
+type Dispatcher struct {
+	storage   Storage
+	txManager TransactionManager
+	client    *http.Client
+
+	batchSize      uint64
+	pollInterval   time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	lease          time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Option - dispatcher option
+type Option func(d *Dispatcher)
+
+// WithBatchSize - max number of deliveries claimed per batch
+func WithBatchSize(n uint64) Option {
+	return func(d *Dispatcher) {
+		d.batchSize = n
+	}
+}
+
+// WithPollInterval - pause between polls when there are no due deliveries
+func WithPollInterval(interval time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.pollInterval = interval
+	}
+}
+
+// WithMaxAttempts - number of attempts before delivery becomes dead
+func WithMaxAttempts(n int) Option {
+	return func(d *Dispatcher) {
+		d.maxAttempts = n
+	}
+}
+
+// WithBackoff - exponential backoff between attempts
+func WithBackoff(initial, max time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.initialBackoff = initial
+		d.maxBackoff = max
+	}
+}
+
+// WithLease - time claimed deliveries are hidden from other dispatchers, it must exceed time of sending of a batch
+func WithLease(d time.Duration) Option {
+	return func(dispatcher *Dispatcher) {
+		dispatcher.lease = d
+	}
+}
+
+// NewDispatcher - returns dispatcher
+func NewDispatcher(storage Storage, txManager TransactionManager, client *http.Client, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		storage:        storage,
+		txManager:      txManager,
+		client:         client,
+		batchSize:      50,
+		pollInterval:   time.Second,
+		maxAttempts:    10,
+		initialBackoff: 5 * time.Second,
+		maxBackoff:     time.Hour,
+		lease:          5 * time.Minute,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Start - starts dispatcher in background, it is stopped by closer
+func (d *Dispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+
+	closer.Add(d.Stop)
+
+	go func() {
+		defer close(d.done)
+		d.Run(ctx)
+	}()
+}
+
+// Stop - stops dispatcher and waits for batch in progress
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("webhooks: stop: %w", ctx.Err())
+	}
+}
+
+// Run - dispatches deliveries until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		n, err := d.DispatchBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.ErrorKV(ctx, "webhooks: dispatch batch", "error", err.Error())
+		}
+
+		if n == int(d.batchSize) && err == nil {
+			continue // there might be more due deliveries
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// DispatchBatch - claims due deliveries, sends them and saves their state, returns number of processed deliveries
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	deliveries, err := d.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		processed int
+		errs      []error
+	)
+	for i := range deliveries {
+		d.deliver(ctx, &deliveries[i])
+
+		// delivery which state is not saved is sent again after its lease expires
+		if err = d.storage.UpdateDelivery(ctx, &deliveries[i]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		processed++
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// claim - returns due deliveries leased till now + lease, so that they are not fetched by other dispatchers
+// while they are sent
+func (d *Dispatcher) claim(ctx context.Context) ([]Delivery, error) {
+	var deliveries []Delivery
+	err := d.txManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			var err error
+			if deliveries, err = d.storage.FetchDueDeliveries(txCtx, time.Now(), d.batchSize); err != nil {
+				return err
+			}
+
+			leasedUntil := time.Now().Add(d.lease)
+			for i := range deliveries {
+				leased := deliveries[i]
+				leased.NextAttemptAt = leasedUntil
+				if err = d.storage.UpdateDelivery(txCtx, &leased); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// deliver - sends delivery and updates its state
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	delivery.Attempts++
+
+	err := d.send(ctx, delivery)
+	if err == nil {
+		delivery.Status = DeliveryStatusDelivered
+		delivery.DeliveredAt = time.Now()
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.maxAttempts {
+		delivery.Status = DeliveryStatusDead
+		logger.ErrorKV(ctx, "webhooks: delivery is dead",
+			"id", delivery.ID, "subscription_id", delivery.SubscriptionID.String(), "event_id", delivery.EventID,
+			"attempts", delivery.Attempts, "error", delivery.LastError)
+		return
+	}
+
+	delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+	logger.WarnKV(ctx, "webhooks: delivery failed",
+		"id", delivery.ID, "subscription_id", delivery.SubscriptionID.String(), "event_id", delivery.EventID,
+		"attempts", delivery.Attempts, "error", delivery.LastError)
+}
+
+// send - POSTs signed payload, any 2xx response is success
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	now := time.Now()
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(HeaderID, delivery.EventID)
+	req.Header.Set(HeaderTimestamp, fmt.Sprint(now.Unix()))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, now, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// backoff - delay before next attempt: initial, initial*2, initial*4, ... up to max
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.initialBackoff
+	for i := 1; i < attempts; i++ {
+		if delay *= 2; delay >= d.maxBackoff {
+			return d.maxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,296 @@
+//go:build test
+
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStorage - in-memory subscriptions and deliveries, transactions are not isolated
+type fakeStorage struct {
+	mu            sync.Mutex
+	subscriptions map[uuid.UUID]Subscription
+	deliveries    map[int64]Delivery
+	lastID        int64
+	inTx          bool
+}
+
+func newFakeStorage(subs ...Subscription) *fakeStorage {
+	s := &fakeStorage{
+		subscriptions: make(map[uuid.UUID]Subscription),
+		deliveries:    make(map[int64]Delivery),
+	}
+	for _, sub := range subs {
+		s.subscriptions[sub.ID] = sub
+	}
+	return s
+}
+
+func (s *fakeStorage) CreateSubscription(_ context.Context, sub *Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriptions[sub.ID] = *sub
+	return nil
+}
+
+func (s *fakeStorage) ListSubscriptions(ctx context.Context) ([]Subscription, error) {
+	return s.ListSubscriptionsByEventType(ctx, "")
+}
+
+func (s *fakeStorage) ListSubscriptionsByEventType(_ context.Context, eventType string) ([]Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var res []Subscription
+	for _, sub := range s.subscriptions {
+		for _, et := range sub.EventTypes {
+			if eventType == "" || et == eventType {
+				res = append(res, sub)
+				break
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID.String() < res[j].ID.String() })
+	return res, nil
+}
+
+func (s *fakeStorage) DeleteSubscription(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[id]; !ok {
+		return models.ErrNotFound
+	}
+	delete(s.subscriptions, id)
+	for deliveryID, d := range s.deliveries {
+		if d.SubscriptionID == id {
+			delete(s.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+func (s *fakeStorage) CreateDeliveries(_ context.Context, deliveries []Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+next:
+	for _, d := range deliveries {
+		for _, existing := range s.deliveries {
+			if existing.SubscriptionID == d.SubscriptionID && existing.EventID == d.EventID {
+				continue next
+			}
+		}
+		s.lastID++
+		d.ID = s.lastID
+		s.deliveries[d.ID] = d
+	}
+	return nil
+}
+
+func (s *fakeStorage) FetchDueDeliveries(_ context.Context, now time.Time, limit uint64) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var res []Delivery
+	for _, d := range s.deliveries {
+		if d.Status == DeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			sub := s.subscriptions[d.SubscriptionID]
+			d.URL, d.Secret = sub.URL, sub.Secret
+			res = append(res, d)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if uint64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (s *fakeStorage) UpdateDelivery(_ context.Context, delivery *Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.deliveries[delivery.ID]; !ok {
+		return models.ErrNotFound
+	}
+	d := *delivery
+	d.URL, d.Secret = "", ""
+	s.deliveries[d.ID] = d
+	return nil
+}
+
+func (s *fakeStorage) RunReadCommitted(ctx context.Context, _ pgx.TxAccessMode, f func(ctx context.Context) error) error {
+	s.setInTx(true)
+	defer s.setInTx(false)
+
+	return f(ctx)
+}
+
+func (s *fakeStorage) setInTx(inTx bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inTx = inTx
+}
+
+func (s *fakeStorage) Delivery(id int64) Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.deliveries[id]
+}
+
+func TestDispatcher_DispatchBatch(t *testing.T) {
+	const (
+		secret  = "secret"
+		payload = `{"specversion":"1.0","id":"1"}`
+	)
+
+	tests := []struct {
+		name         string
+		statuses     []int // response status per attempt
+		attempts     int   // number of DispatchBatch calls
+		wantStatus   DeliveryStatus
+		wantAttempts int
+	}{
+		{
+			name:         "Test 1. Delivered with valid signature.",
+			statuses:     []int{http.StatusOK},
+			attempts:     1,
+			wantStatus:   DeliveryStatusDelivered,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Test 2. Delivered after retries.",
+			statuses:     []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusNoContent},
+			attempts:     3,
+			wantStatus:   DeliveryStatusDelivered,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Test 3. Dead after max attempts.",
+			statuses:     []int{http.StatusGone, http.StatusGone, http.StatusGone, http.StatusGone},
+			attempts:     4,
+			wantStatus:   DeliveryStatusDead,
+			wantAttempts: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				received int
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+
+				assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "event-1", r.Header.Get(HeaderID))
+				assert.True(t, Verify(secret, r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), body))
+				assert.JSONEq(t, payload, string(body))
+
+				mu.Lock()
+				defer mu.Unlock()
+				w.WriteHeader(tt.statuses[received])
+				received++
+			}))
+			defer srv.Close()
+
+			var (
+				ctx     = context.Background()
+				sub     = Subscription{ID: uuid.New(), URL: srv.URL, EventTypes: []string{"order.paid"}, Secret: secret}
+				storage = newFakeStorage(sub)
+			)
+			require.NoError(t, storage.CreateDeliveries(ctx, []Delivery{{
+				SubscriptionID: sub.ID,
+				EventID:        "event-1",
+				EventType:      "order.paid",
+				Payload:        []byte(payload),
+				Status:         DeliveryStatusPending,
+			}}))
+
+			dispatcher := NewDispatcher(storage, storage, srv.Client(),
+				WithMaxAttempts(3),
+				WithBackoff(0, 0),
+			)
+			for i := 0; i < tt.attempts; i++ {
+				_, err := dispatcher.DispatchBatch(ctx)
+				require.NoError(t, err)
+			}
+
+			got := storage.Delivery(1)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantAttempts, got.Attempts)
+			assert.Equal(t, tt.wantAttempts, received)
+			if tt.wantStatus == DeliveryStatusDead {
+				assert.Equal(t, "unexpected status 410 Gone", got.LastError)
+			}
+		})
+	}
+}
+
+func TestDispatcher_DispatchBatch_lease(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		storage *fakeStorage
+		claimed Delivery
+		inTx    bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storage.mu.Lock()
+		claimed, inTx = storage.deliveries[1], storage.inTx
+		storage.mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sub := Subscription{ID: uuid.New(), URL: srv.URL, EventTypes: []string{"order.paid"}, Secret: "secret"}
+	storage = newFakeStorage(sub)
+	require.NoError(t, storage.CreateDeliveries(ctx, []Delivery{{
+		SubscriptionID: sub.ID,
+		EventID:        "event-1",
+		EventType:      "order.paid",
+		Payload:        []byte(`{}`),
+		Status:         DeliveryStatusPending,
+	}}))
+
+	dispatcher := NewDispatcher(storage, storage, srv.Client(), WithLease(time.Minute))
+	got, err := dispatcher.DispatchBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	// delivery is sent outside of transaction while it is leased
+	assert.False(t, inTx)
+	assert.Equal(t, DeliveryStatusPending, claimed.Status)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), claimed.NextAttemptAt, time.Second)
+	assert.Equal(t, DeliveryStatusDelivered, storage.Delivery(1).Status)
+}
+
+func TestVerify(t *testing.T) {
+	var (
+		now  = time.Unix(1700000000, 0)
+		body = []byte(`{"id":"1"}`)
+		sig  = Sign("secret", now, body)
+	)
+
+	assert.True(t, Verify("secret", "1700000000", sig, body))
+	assert.False(t, Verify("other", "1700000000", sig, body))
+	assert.False(t, Verify("secret", "1700000001", sig, body))
+	assert.False(t, Verify("secret", "1700000000", sig, []byte(`{"id":"2"}`)))
+}
@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+)
+
+// Fanout - outbox publisher creating delivery per subscription of the event type.
+// Relay publishes at least once: message is published again when its state is not saved,
+// deliveries are inserted with ON CONFLICT DO NOTHING on (subscription, event), so repeats are skipped
+type Fanout struct {
+	storage Storage
+	encoder *outbox.CloudEventsEncoder
+}
+
+// Check that we implemet contract for relay
+var _ outbox.Publisher = (*Fanout)(nil)
+
+// NewFanout - returns fanout, payloads are structured CloudEvents with the source
+func NewFanout(storage Storage, source string) (*Fanout, error) {
+	encoder, err := outbox.NewCloudEventsEncoder(outbox.CloudEventsConfig{
+		Source: source,
+		Mode:   outbox.ContentModeStructured,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fanout{
+		storage: storage,
+		encoder: encoder,
+	}, nil
+}
+
+func (f *Fanout) Publish(ctx context.Context, msg outbox.Message) error {
+	subs, err := f.storage.ListSubscriptionsByEventType(ctx, msg.EventType)
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+
+	envelope, err := f.encoder.Encode(msg)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]Delivery, 0, len(subs))
+	for _, sub := range subs {
+		deliveries = append(deliveries, Delivery{
+			SubscriptionID: sub.ID,
+			EventID:        strconv.FormatInt(msg.ID, 10),
+			EventType:      msg.EventType,
+			Payload:        envelope.Body,
+			Status:         DeliveryStatusPending,
+			NextAttemptAt:  now,
+		})
+	}
+
+	return f.storage.CreateDeliveries(ctx, deliveries)
+}
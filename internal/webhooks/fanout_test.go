@@ -0,0 +1,48 @@
+//go:build test
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/moguchev/microservices_courcse/orders_management_system/internal/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanout_Publish(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		paid    = Subscription{ID: uuid.New(), URL: "http://paid", EventTypes: []string{"order.paid"}}
+		all     = Subscription{ID: uuid.New(), URL: "http://all", EventTypes: []string{"order.created", "order.paid"}}
+		storage = newFakeStorage(paid, all)
+		msg     = outbox.Message{ID: 7, AggregateID: "order-1", Sequence: 2, EventType: "order.paid"}
+	)
+
+	fanout, err := NewFanout(storage, "/oms")
+	require.NoError(t, err)
+
+	require.NoError(t, fanout.Publish(ctx, msg))
+	// relay retries message, deliveries are not duplicated
+	require.NoError(t, fanout.Publish(ctx, msg))
+	// nobody is subscribed
+	require.NoError(t, fanout.Publish(ctx, outbox.Message{ID: 8, EventType: "order.cancelled"}))
+
+	require.Len(t, storage.deliveries, 2)
+
+	subscribers := make(map[uuid.UUID]bool)
+	for _, d := range storage.deliveries {
+		subscribers[d.SubscriptionID] = true
+		assert.Equal(t, "7", d.EventID)
+		assert.Equal(t, DeliveryStatusPending, d.Status)
+
+		var event map[string]any
+		require.NoError(t, json.Unmarshal(d.Payload, &event))
+		assert.Equal(t, "order.paid", event["type"])
+		assert.Equal(t, "order-1", event["subject"])
+	}
+	assert.Equal(t, map[uuid.UUID]bool{paid.ID: true, all.ID: true}, subscribers)
+}
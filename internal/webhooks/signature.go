@@ -0,0 +1,42 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderID - id of event, the same for all attempts so that receivers can deduplicate
+	HeaderID = "X-Webhook-Id"
+	// HeaderTimestamp - unix time of attempt, it is signed to prevent replays
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature - "sha256=" + hex HMAC-SHA256 of "<timestamp>.<body>" with subscription secret
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign - returns signature of body sent at timestamp
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify - checks signature of body, receivers should also reject stale timestamps
+func Verify(secret, timestamp, signature string, body []byte) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	expected := Sign(secret, time.Unix(ts, 0), body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
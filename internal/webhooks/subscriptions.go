@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Subscriptions - management of subscriptions
+type Subscriptions struct {
+	storage Storage
+}
+
+// NewSubscriptions - returns subscriptions management
+func NewSubscriptions(storage Storage) *Subscriptions {
+	return &Subscriptions{
+		storage: storage,
+	}
+}
+
+// Register - subscribes url to event types, secret is generated and returned only here
+func (s *Subscriptions) Register(ctx context.Context, url string, eventTypes []string) (*Subscription, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &Subscription{
+		ID:         uuid.New(),
+		URL:        url,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		CreatedAt:  time.Now(),
+	}
+	if err = s.storage.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// List - returns all subscriptions
+func (s *Subscriptions) List(ctx context.Context) ([]Subscription, error) {
+	return s.storage.ListSubscriptions(ctx)
+}
+
+// Delete - deletes subscription, pending deliveries are dropped
+func (s *Subscriptions) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.storage.DeleteSubscription(ctx, id)
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("webhooks: generate secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,74 @@
+// Package webhooks - HTTP callbacks of order events to subscribed partner endpoints
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Subscription - partner endpoint subscribed to event types
+type Subscription struct {
+	ID         uuid.UUID
+	URL        string
+	EventTypes []string
+	// Secret - key of HMAC signature of payloads
+	Secret    string
+	CreatedAt time.Time
+}
+
+// DeliveryStatus - status of delivery to one subscription
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	// DeliveryStatusDead - delivery attempts are exhausted
+	DeliveryStatusDead DeliveryStatus = "dead"
+)
+
+// Delivery - event to be POSTed to subscription
+type Delivery struct {
+	ID             int64
+	SubscriptionID uuid.UUID
+	// EventID - id of event, delivery is created once per subscription and event
+	EventID   string
+	EventType string
+	Payload   []byte
+
+	Status        DeliveryStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	DeliveredAt   time.Time
+
+	// URL and Secret of subscription, filled on fetch
+	URL    string
+	Secret string
+}
+
+type (
+	// Storage - subscriptions and deliveries tables
+	Storage interface {
+		CreateSubscription(ctx context.Context, sub *Subscription) error
+		ListSubscriptions(ctx context.Context) ([]Subscription, error)
+		// ListSubscriptionsByEventType - returns subscriptions to event type
+		ListSubscriptionsByEventType(ctx context.Context, eventType string) ([]Subscription, error)
+		// DeleteSubscription - deletes subscription with its deliveries, returns models.ErrNotFound when it does not exist
+		DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+		// CreateDeliveries - saves deliveries, already existing deliveries of the same event are skipped
+		CreateDeliveries(ctx context.Context, deliveries []Delivery) error
+		// FetchDueDeliveries - returns pending deliveries due at now and locks them till the end of transaction,
+		// deliveries locked by other dispatchers are skipped
+		FetchDueDeliveries(ctx context.Context, now time.Time, limit uint64) ([]Delivery, error)
+		// UpdateDelivery - saves status, attempts, last error, next attempt and delivery time
+		UpdateDelivery(ctx context.Context, delivery *Delivery) error
+	}
+
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+	}
+)
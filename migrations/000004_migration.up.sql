@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_slot_id int8;
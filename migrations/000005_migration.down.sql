@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS currency_code,
+    DROP COLUMN IF EXISTS subtotal,
+    DROP COLUMN IF EXISTS delivery_cost,
+    DROP COLUMN IF EXISTS total;
@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS currency_code varchar(3),
+    ADD COLUMN IF NOT EXISTS subtotal numeric(20, 9),
+    ADD COLUMN IF NOT EXISTS delivery_cost numeric(20, 9),
+    ADD COLUMN IF NOT EXISTS total numeric(20, 9);
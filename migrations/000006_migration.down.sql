@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS discount,
+    DROP COLUMN IF EXISTS promo_code;
+
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_campaigns;
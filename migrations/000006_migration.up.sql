@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS promo_campaigns (
+    id bigserial PRIMARY KEY,
+    code text NOT NULL UNIQUE,
+    discount_type text NOT NULL CHECK (discount_type IN ('percentage', 'fixed')),
+    percent_off int4 CHECK (percent_off BETWEEN 0 AND 10000), -- basis points
+    amount_off numeric(20, 9),
+    currency_code varchar(3),
+    valid_from timestamptz NOT NULL,
+    valid_to timestamptz NOT NULL,
+    usage_limit_per_user int4 NOT NULL DEFAULT 0 -- 0 - unlimited
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id bigserial PRIMARY KEY,
+    campaign_id int8 NOT NULL REFERENCES promo_campaigns (id),
+    user_id int8 NOT NULL,
+    order_id uuid NOT NULL UNIQUE,
+    discount numeric(20, 9) NOT NULL,
+    currency_code varchar(3) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS promo_redemptions_campaign_id_user_id_idx ON promo_redemptions (campaign_id, user_id);
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS discount numeric(20, 9),
+    ADD COLUMN IF NOT EXISTS promo_code text;
@@ -0,0 +1,12 @@
+ALTER TABLE orders_outbox_messages
+    DROP COLUMN IF EXISTS event_type,
+    DROP COLUMN IF EXISTS created_at;
+
+DROP INDEX IF EXISTS orders_awaiting_payment_expires_at_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS payment_id,
+    DROP COLUMN IF EXISTS payment_url,
+    DROP COLUMN IF EXISTS payment_expires_at,
+    DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,13 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'awaiting_payment',
+    ADD COLUMN IF NOT EXISTS payment_id text,
+    ADD COLUMN IF NOT EXISTS payment_url text,
+    ADD COLUMN IF NOT EXISTS payment_expires_at timestamptz,
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS orders_awaiting_payment_expires_at_idx ON orders (payment_expires_at)
+    WHERE status = 'awaiting_payment';
+
+ALTER TABLE orders_outbox_messages
+    ADD COLUMN IF NOT EXISTS event_type text NOT NULL DEFAULT 'order.created',
+    ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now();
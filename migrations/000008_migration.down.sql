@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS inbox_dead_letters;
+DROP TABLE IF EXISTS inbox_messages;
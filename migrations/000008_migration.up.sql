@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS inbox_messages (
+    message_id text PRIMARY KEY,
+    topic text NOT NULL,
+    processed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS inbox_dead_letters (
+    id bigserial PRIMARY KEY,
+    message_id text NOT NULL,
+    topic text NOT NULL,
+    key text,
+    headers jsonb,
+    payload jsonb,
+    attempts int4 NOT NULL,
+    last_error text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
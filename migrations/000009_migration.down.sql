@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS orders_outbox_messages_dead_idx;
+DROP INDEX IF EXISTS orders_outbox_messages_pending_idx;
+
+ALTER TABLE orders_outbox_messages
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS sent_at;
@@ -0,0 +1,12 @@
+ALTER TABLE orders_outbox_messages
+    ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS attempts int4 NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_error text,
+    ADD COLUMN IF NOT EXISTS next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS sent_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS orders_outbox_messages_pending_idx ON orders_outbox_messages (next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS orders_outbox_messages_dead_idx ON orders_outbox_messages (id)
+    WHERE status = 'dead';
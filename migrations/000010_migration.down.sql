@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS orders_outbox_messages_order_id_sequence_idx;
+
+ALTER TABLE orders_outbox_messages
+    DROP COLUMN IF EXISTS sequence;
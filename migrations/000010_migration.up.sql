@@ -0,0 +1,15 @@
+ALTER TABLE orders_outbox_messages
+    ADD COLUMN IF NOT EXISTS sequence int8;
+
+UPDATE orders_outbox_messages m
+SET sequence = s.sequence
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY order_id ORDER BY id) AS sequence
+    FROM orders_outbox_messages
+) s
+WHERE m.id = s.id;
+
+ALTER TABLE orders_outbox_messages
+    ALTER COLUMN sequence SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS orders_outbox_messages_order_id_sequence_idx ON orders_outbox_messages (order_id, sequence);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id uuid PRIMARY KEY,
+    url text NOT NULL,
+    event_types text[] NOT NULL,
+    secret text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_subscriptions_event_types_idx ON webhook_subscriptions USING gin (event_types);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id uuid NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id text NOT NULL,
+    event_type text NOT NULL,
+    payload bytea NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts int4 NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'pending';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id bigserial PRIMARY KEY,
+    order_id uuid NOT NULL,
+    status text NOT NULL,
+    event_type text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_idx ON order_status_history (order_id, id);
+
+INSERT INTO order_status_history (order_id, status, event_type, created_at)
+SELECT
+    id,
+    status,
+    CASE status
+        WHEN 'paid' THEN 'order.paid'
+        WHEN 'cancelled' THEN 'order.cancelled'
+        ELSE 'order.created'
+    END,
+    updated_at
+FROM orders;
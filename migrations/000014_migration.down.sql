@@ -0,0 +1,2 @@
+ALTER TABLE orders_outbox_messages
+    DROP COLUMN IF EXISTS tracestate;
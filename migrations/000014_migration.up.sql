@@ -0,0 +1,2 @@
+ALTER TABLE orders_outbox_messages
+    ADD COLUMN IF NOT EXISTS tracestate text;
@@ -0,0 +1,67 @@
+CREATE TABLE IF NOT EXISTS order_events (
+    order_id uuid NOT NULL,
+    version int8 NOT NULL CHECK (version > 0),
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (order_id, version)
+);
+
+-- event log is append-only, orders table is its projection
+CREATE OR REPLACE FUNCTION order_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'order_events is append-only: % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER order_events_no_update_delete
+    BEFORE UPDATE OR DELETE ON order_events
+    FOR EACH ROW EXECUTE FUNCTION order_events_append_only();
+
+CREATE TRIGGER order_events_no_truncate
+    BEFORE TRUNCATE ON order_events
+    FOR EACH STATEMENT EXECUTE FUNCTION order_events_append_only();
+
+-- existing orders get stream of creation and, for paid or cancelled ones, final status
+INSERT INTO order_events (order_id, version, event_type, payload, created_at)
+SELECT
+    id,
+    1,
+    'order.created',
+    jsonb_strip_nulls(jsonb_build_object(
+        'user_id', user_id,
+        'status', 'awaiting_payment',
+        'delivery_variant_id', delivery_variant_id,
+        'delivery_date', delivery_date AT TIME ZONE 'UTC',
+        'delivery_slot_id', delivery_slot_id,
+        'payment_url', payment_url,
+        'payment_expires_at', payment_expires_at,
+        'items', items::jsonb,
+        'currency_code', currency_code,
+        'subtotal', subtotal::text,
+        'delivery_cost', delivery_cost::text,
+        'discount', discount::text,
+        'promo_code', promo_code,
+        'total', total::text,
+        'payment_id', payment_id
+    )),
+    updated_at
+FROM orders
+ON CONFLICT DO NOTHING;
+
+INSERT INTO order_events (order_id, version, event_type, payload, created_at)
+SELECT
+    id,
+    2,
+    CASE status
+        WHEN 'paid' THEN 'order.paid'
+        ELSE 'order.cancelled'
+    END,
+    CASE status
+        WHEN 'paid' THEN jsonb_strip_nulls(jsonb_build_object('payment_id', payment_id))
+        ELSE '{}'::jsonb
+    END,
+    updated_at
+FROM orders
+WHERE status IN ('paid', 'cancelled')
+ON CONFLICT DO NOTHING;
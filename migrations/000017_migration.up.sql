@@ -0,0 +1,12 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS version int8 NOT NULL DEFAULT 0;
+
+-- projection version is version of the last event of the order
+UPDATE orders o
+SET version = e.version
+FROM (
+    SELECT order_id, MAX(version) AS version
+    FROM order_events
+    GROUP BY order_id
+) e
+WHERE o.id = e.order_id;
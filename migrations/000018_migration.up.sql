@@ -0,0 +1,3 @@
+-- change made by the event, e.g. changed fields of the order and their new values
+ALTER TABLE orders_outbox_messages
+    ADD COLUMN IF NOT EXISTS payload jsonb;
@@ -19,7 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrdersManagementSystemService_CreateOrder_FullMethodName = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/CreateOrder"
+	OrdersManagementSystemService_CreateOrder_FullMethodName            = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/CreateOrder"
+	OrdersManagementSystemService_UpdateOrder_FullMethodName            = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/UpdateOrder"
+	OrdersManagementSystemService_ConfirmPayment_FullMethodName         = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/ConfirmPayment"
+	OrdersManagementSystemService_WatchOrder_FullMethodName             = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/WatchOrder"
+	OrdersManagementSystemService_ListDeadOutboxMessages_FullMethodName = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/ListDeadOutboxMessages"
+	OrdersManagementSystemService_GetOutboxMessage_FullMethodName       = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/GetOutboxMessage"
+	OrdersManagementSystemService_RequeueOutboxMessage_FullMethodName   = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/RequeueOutboxMessage"
+	OrdersManagementSystemService_RegisterWebhook_FullMethodName        = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/RegisterWebhook"
+	OrdersManagementSystemService_ListWebhooks_FullMethodName           = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/ListWebhooks"
+	OrdersManagementSystemService_DeleteWebhook_FullMethodName          = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/DeleteWebhook"
+	OrdersManagementSystemService_GetLogLevel_FullMethodName            = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/GetLogLevel"
+	OrdersManagementSystemService_SetLogLevel_FullMethodName            = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/SetLogLevel"
+	OrdersManagementSystemService_ListOrderAuditLog_FullMethodName      = "/github.com.moguchev.microservices.orders_management_system.OrdersManagementSystemService/ListOrderAuditLog"
 )
 
 // OrdersManagementSystemServiceClient is the client API for OrdersManagementSystemService service.
@@ -28,6 +40,30 @@ const (
 type OrdersManagementSystemServiceClient interface {
 	// CreateOrder - метод создания заказа
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	// UpdateOrder - метод изменения товаров и доставки заказа до начала сборки
+	UpdateOrder(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*UpdateOrderResponse, error)
+	// ConfirmPayment - метод подтверждения оплаты заказа
+	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error)
+	// WatchOrder - метод подписки на изменения статуса заказа
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrdersManagementSystemService_WatchOrderClient, error)
+	// ListDeadOutboxMessages - метод получения сообщений outbox, доставка которых не удалась
+	ListDeadOutboxMessages(ctx context.Context, in *ListDeadOutboxMessagesRequest, opts ...grpc.CallOption) (*ListDeadOutboxMessagesResponse, error)
+	// GetOutboxMessage - метод получения сообщения outbox
+	GetOutboxMessage(ctx context.Context, in *GetOutboxMessageRequest, opts ...grpc.CallOption) (*GetOutboxMessageResponse, error)
+	// RequeueOutboxMessage - метод повторной отправки сообщения outbox
+	RequeueOutboxMessage(ctx context.Context, in *RequeueOutboxMessageRequest, opts ...grpc.CallOption) (*RequeueOutboxMessageResponse, error)
+	// RegisterWebhook - метод регистрации подписки на события заказов
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	// ListWebhooks - метод получения подписок на события заказов
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// DeleteWebhook - метод удаления подписки на события заказов
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// GetLogLevel - метод получения уровня логирования
+	GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error)
+	// SetLogLevel - метод изменения уровня логирования без перезапуска
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// ListOrderAuditLog - метод получения истории изменений заказа: кто, когда и откуда изменил заказ
+	ListOrderAuditLog(ctx context.Context, in *ListOrderAuditLogRequest, opts ...grpc.CallOption) (*ListOrderAuditLogResponse, error)
 }
 
 type ordersManagementSystemServiceClient struct {
@@ -47,12 +83,167 @@ func (c *ordersManagementSystemServiceClient) CreateOrder(ctx context.Context, i
 	return out, nil
 }
 
+func (c *ordersManagementSystemServiceClient) UpdateOrder(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*UpdateOrderResponse, error) {
+	out := new(UpdateOrderResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_UpdateOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error) {
+	out := new(ConfirmPaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_ConfirmPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrdersManagementSystemService_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrdersManagementSystemService_ServiceDesc.Streams[0], OrdersManagementSystemService_WatchOrder_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ordersManagementSystemServiceWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrdersManagementSystemService_WatchOrderClient interface {
+	Recv() (*WatchOrderResponse, error)
+	grpc.ClientStream
+}
+
+type ordersManagementSystemServiceWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *ordersManagementSystemServiceWatchOrderClient) Recv() (*WatchOrderResponse, error) {
+	m := new(WatchOrderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ordersManagementSystemServiceClient) ListDeadOutboxMessages(ctx context.Context, in *ListDeadOutboxMessagesRequest, opts ...grpc.CallOption) (*ListDeadOutboxMessagesResponse, error) {
+	out := new(ListDeadOutboxMessagesResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_ListDeadOutboxMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) GetOutboxMessage(ctx context.Context, in *GetOutboxMessageRequest, opts ...grpc.CallOption) (*GetOutboxMessageResponse, error) {
+	out := new(GetOutboxMessageResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_GetOutboxMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) RequeueOutboxMessage(ctx context.Context, in *RequeueOutboxMessageRequest, opts ...grpc.CallOption) (*RequeueOutboxMessageResponse, error) {
+	out := new(RequeueOutboxMessageResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_RequeueOutboxMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_RegisterWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error) {
+	out := new(GetLogLevelResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_GetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersManagementSystemServiceClient) ListOrderAuditLog(ctx context.Context, in *ListOrderAuditLogRequest, opts ...grpc.CallOption) (*ListOrderAuditLogResponse, error) {
+	out := new(ListOrderAuditLogResponse)
+	err := c.cc.Invoke(ctx, OrdersManagementSystemService_ListOrderAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersManagementSystemServiceServer is the server API for OrdersManagementSystemService service.
 // All implementations must embed UnimplementedOrdersManagementSystemServiceServer
 // for forward compatibility
 type OrdersManagementSystemServiceServer interface {
 	// CreateOrder - метод создания заказа
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	// UpdateOrder - метод изменения товаров и доставки заказа до начала сборки
+	UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error)
+	// ConfirmPayment - метод подтверждения оплаты заказа
+	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error)
+	// WatchOrder - метод подписки на изменения статуса заказа
+	WatchOrder(*WatchOrderRequest, OrdersManagementSystemService_WatchOrderServer) error
+	// ListDeadOutboxMessages - метод получения сообщений outbox, доставка которых не удалась
+	ListDeadOutboxMessages(context.Context, *ListDeadOutboxMessagesRequest) (*ListDeadOutboxMessagesResponse, error)
+	// GetOutboxMessage - метод получения сообщения outbox
+	GetOutboxMessage(context.Context, *GetOutboxMessageRequest) (*GetOutboxMessageResponse, error)
+	// RequeueOutboxMessage - метод повторной отправки сообщения outbox
+	RequeueOutboxMessage(context.Context, *RequeueOutboxMessageRequest) (*RequeueOutboxMessageResponse, error)
+	// RegisterWebhook - метод регистрации подписки на события заказов
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	// ListWebhooks - метод получения подписок на события заказов
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// DeleteWebhook - метод удаления подписки на события заказов
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// GetLogLevel - метод получения уровня логирования
+	GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error)
+	// SetLogLevel - метод изменения уровня логирования без перезапуска
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// ListOrderAuditLog - метод получения истории изменений заказа: кто, когда и откуда изменил заказ
+	ListOrderAuditLog(context.Context, *ListOrderAuditLogRequest) (*ListOrderAuditLogResponse, error)
 	mustEmbedUnimplementedOrdersManagementSystemServiceServer()
 }
 
@@ -63,6 +254,42 @@ type UnimplementedOrdersManagementSystemServiceServer struct {
 func (UnimplementedOrdersManagementSystemServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrdersManagementSystemServiceServer) UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPayment not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) WatchOrder(*WatchOrderRequest, OrdersManagementSystemService_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) ListDeadOutboxMessages(context.Context, *ListDeadOutboxMessagesRequest) (*ListDeadOutboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadOutboxMessages not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) GetOutboxMessage(context.Context, *GetOutboxMessageRequest) (*GetOutboxMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboxMessage not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) RequeueOutboxMessage(context.Context, *RequeueOutboxMessageRequest) (*RequeueOutboxMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueOutboxMessage not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedOrdersManagementSystemServiceServer) ListOrderAuditLog(context.Context, *ListOrderAuditLogRequest) (*ListOrderAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderAuditLog not implemented")
+}
 func (UnimplementedOrdersManagementSystemServiceServer) mustEmbedUnimplementedOrdersManagementSystemServiceServer() {
 }
 
@@ -95,6 +322,225 @@ func _OrdersManagementSystemService_CreateOrder_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersManagementSystemService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).UpdateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_UpdateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).UpdateOrder(ctx, req.(*UpdateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_ConfirmPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).ConfirmPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_ConfirmPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).ConfirmPayment(ctx, req.(*ConfirmPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersManagementSystemServiceServer).WatchOrder(m, &ordersManagementSystemServiceWatchOrderServer{stream})
+}
+
+type OrdersManagementSystemService_WatchOrderServer interface {
+	Send(*WatchOrderResponse) error
+	grpc.ServerStream
+}
+
+type ordersManagementSystemServiceWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *ordersManagementSystemServiceWatchOrderServer) Send(m *WatchOrderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrdersManagementSystemService_ListDeadOutboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadOutboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).ListDeadOutboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_ListDeadOutboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).ListDeadOutboxMessages(ctx, req.(*ListDeadOutboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_GetOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboxMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).GetOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_GetOutboxMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).GetOutboxMessage(ctx, req.(*GetOutboxMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_RequeueOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueOutboxMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).RequeueOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_RequeueOutboxMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).RequeueOutboxMessage(ctx, req.(*RequeueOutboxMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_RegisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).GetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_GetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).GetLogLevel(ctx, req.(*GetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersManagementSystemService_ListOrderAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersManagementSystemServiceServer).ListOrderAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersManagementSystemService_ListOrderAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersManagementSystemServiceServer).ListOrderAuditLog(ctx, req.(*ListOrderAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersManagementSystemService_ServiceDesc is the grpc.ServiceDesc for OrdersManagementSystemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -106,7 +552,57 @@ var OrdersManagementSystemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _OrdersManagementSystemService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "UpdateOrder",
+			Handler:    _OrdersManagementSystemService_UpdateOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmPayment",
+			Handler:    _OrdersManagementSystemService_ConfirmPayment_Handler,
+		},
+		{
+			MethodName: "ListDeadOutboxMessages",
+			Handler:    _OrdersManagementSystemService_ListDeadOutboxMessages_Handler,
+		},
+		{
+			MethodName: "GetOutboxMessage",
+			Handler:    _OrdersManagementSystemService_GetOutboxMessage_Handler,
+		},
+		{
+			MethodName: "RequeueOutboxMessage",
+			Handler:    _OrdersManagementSystemService_RequeueOutboxMessage_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _OrdersManagementSystemService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _OrdersManagementSystemService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _OrdersManagementSystemService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetLogLevel",
+			Handler:    _OrdersManagementSystemService_GetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _OrdersManagementSystemService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ListOrderAuditLog",
+			Handler:    _OrdersManagementSystemService_ListOrderAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrdersManagementSystemService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/orders_management_system/service.proto",
 }
@@ -0,0 +1,120 @@
+package grpcutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// ProblemDetailsContentType - media type of RFC 7807 error responses
+const ProblemDetailsContentType = "application/problem+json"
+
+// ProblemDetails - RFC 7807 error body
+type ProblemDetails struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          string         `json:"code"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// InvalidParam - field violation extension member of ProblemDetails
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// NewProblemDetails - builds ProblemDetails from gRPC status
+func NewProblemDetails(st *status.Status, httpStatus int, instance string) *ProblemDetails {
+	problem := &ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(httpStatus),
+		Status:   httpStatus,
+		Detail:   st.Message(),
+		Instance: instance,
+		Code:     codeName(st.Code()),
+	}
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			problem.InvalidParams = append(problem.InvalidParams, InvalidParam{
+				Name:   v.GetField(),
+				Reason: v.GetDescription(),
+			})
+		}
+	}
+
+	return problem
+}
+
+// ProblemDetailsErrorHandler - grpc-gateway error handler replying with application/problem+json body
+func ProblemDetailsErrorHandler(ctx context.Context, mux *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	// return Internal when Marshal failed
+	const fallback = `{"type":"about:blank","title":"Internal Server Error","status":500,"code":"INTERNAL"}`
+
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+	}
+
+	st := status.Convert(err)
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if customStatus != nil {
+		httpStatus = customStatus.HTTPStatus
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", ProblemDetailsContentType)
+
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", st.Message())
+	}
+
+	buf, merr := json.Marshal(NewProblemDetails(st, httpStatus, r.URL.Path))
+	if merr != nil {
+		grpclog.Infof("Failed to marshal problem details %q: %v", st, merr)
+		w.WriteHeader(http.StatusInternalServerError)
+		if _, err := io.WriteString(w, fallback); err != nil {
+			grpclog.Infof("Failed to write response: %v", err)
+		}
+		return
+	}
+
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		for k, vs := range md.HeaderMD {
+			for _, v := range vs {
+				w.Header().Add(runtime.MetadataHeaderPrefix+k, v)
+			}
+		}
+	}
+
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(buf); err != nil {
+		grpclog.Infof("Failed to write response: %v", err)
+	}
+}
+
+// codeName - canonical UPPER_SNAKE_CASE name of the code, e.g. INVALID_ARGUMENT
+func codeName(c codes.Code) string {
+	if name, ok := code.Code_name[int32(c)]; ok {
+		return name
+	}
+	return code.Code_UNKNOWN.String()
+}
@@ -0,0 +1,159 @@
+//go:build test
+
+package grpcutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewProblemDetails(t *testing.T) {
+	withViolations := func() *status.Status {
+		st, err := status.New(codes.InvalidArgument, "validation failed").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "items", Description: "value must contain at least 1 item(s)"},
+				{Field: "user_id", Description: "value must be greater than 0"},
+			},
+		})
+		require.NoError(t, err)
+		return st
+	}
+
+	tests := []struct {
+		name       string
+		status     *status.Status
+		httpStatus int
+		want       *ProblemDetails
+	}{
+		{
+			name:       "Test 1. Positive. Field violations.",
+			status:     withViolations(),
+			httpStatus: http.StatusBadRequest,
+			want: &ProblemDetails{
+				Type:     "about:blank",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "validation failed",
+				Instance: "/api/v1/orders",
+				Code:     "INVALID_ARGUMENT",
+				InvalidParams: []InvalidParam{
+					{Name: "items", Reason: "value must contain at least 1 item(s)"},
+					{Name: "user_id", Reason: "value must be greater than 0"},
+				},
+			},
+		},
+		{
+			name:       "Test 2. Positive. Multi-word code name.",
+			status:     status.New(codes.FailedPrecondition, "order is paid"),
+			httpStatus: http.StatusBadRequest,
+			want: &ProblemDetails{
+				Type:     "about:blank",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "order is paid",
+				Instance: "/api/v1/orders",
+				Code:     "FAILED_PRECONDITION",
+			},
+		},
+		{
+			name:       "Test 3. Positive. Unknown code.",
+			status:     status.New(codes.Code(100), "oops"),
+			httpStatus: http.StatusInternalServerError,
+			want: &ProblemDetails{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Detail:   "oops",
+				Instance: "/api/v1/orders",
+				Code:     "UNKNOWN",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewProblemDetails(tt.status, tt.httpStatus, "/api/v1/orders")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProblemDetailsErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		md         metadata.MD
+		wantStatus int
+		wantCode   string
+		wantHeader http.Header
+	}{
+		{
+			name:       "Test 1. Positive. Status is mapped to HTTP status.",
+			err:        status.Error(codes.NotFound, "order not found"),
+			wantStatus: http.StatusNotFound,
+			wantCode:   "NOT_FOUND",
+		},
+		{
+			name:       "Test 2. Positive. Custom HTTP status.",
+			err:        &runtime.HTTPStatusError{HTTPStatus: http.StatusMethodNotAllowed, Err: status.Error(codes.Unimplemented, "method not allowed")},
+			wantStatus: http.StatusMethodNotAllowed,
+			wantCode:   "UNIMPLEMENTED",
+		},
+		{
+			name:       "Test 3. Positive. Unauthenticated sets WWW-Authenticate.",
+			err:        status.Error(codes.Unauthenticated, "Bearer"),
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "UNAUTHENTICATED",
+			wantHeader: http.Header{"Www-Authenticate": {"Bearer"}},
+		},
+		{
+			name:       "Test 4. Positive. Server header metadata is forwarded.",
+			err:        status.Error(codes.Aborted, "version conflict"),
+			md:         metadata.Pairs("x-request-id", "42"),
+			wantStatus: http.StatusConflict,
+			wantCode:   "ABORTED",
+			wantHeader: http.Header{"Grpc-Metadata-X-Request-Id": {"42"}},
+		},
+		{
+			name:       "Test 5. Positive. Non-status error is internal.",
+			err:        errors.New("boom"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "UNKNOWN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{HeaderMD: tt.md})
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/orders/1", nil)
+
+			ProblemDetailsErrorHandler(ctx, runtime.NewServeMux(), nil, w, r, tt.err)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, ProblemDetailsContentType, w.Header().Get("Content-Type"))
+			for k, v := range tt.wantHeader {
+				assert.Equal(t, v, w.Header().Values(k), k)
+			}
+
+			var got ProblemDetails
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tt.wantCode, got.Code)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, "/api/v1/orders/1", got.Instance)
+		})
+	}
+}
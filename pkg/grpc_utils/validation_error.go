@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,3 +29,12 @@ func RPCValidationError(err error) error {
 
 	return status.Error(codes.InvalidArgument, err.Error())
 }
+
+func RPCBadRequestError(violations ...*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, codes.InvalidArgument.String())
+	}
+	return st.Err()
+}
@@ -3,6 +3,7 @@ package logger
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -10,26 +11,104 @@ type contextKey int
 
 const (
 	loggerContextKey contextKey = iota
+	fieldsContextKey
 )
 
+// contextFields - request attributes added to every log line
+type contextFields struct {
+	requestID string
+	userID    uint64
+	method    string
+}
+
 func ToContext(ctx context.Context, l *zap.SugaredLogger) context.Context {
 	return context.WithValue(ctx, loggerContextKey, l)
 }
 
+// FromContext - returns logger of ctx with trace_id, span_id, request_id, user_id and method
+// of ctx when they are present, debug level is enabled for requests in debug window
 func FromContext(ctx context.Context) *zap.SugaredLogger {
 	l := getLogger(ctx)
 
-	return l
+	f := getContextFields(ctx)
+	fields := fieldsFromContext(ctx, f)
+	debug := GetDebugWindow().matches(f)
+	if len(fields) == 0 && !debug {
+		return l
+	}
+
+	log := l.Desugar().With(fields...)
+	if debug {
+		log = withDebugLevel(log)
+	}
+
+	return log.Sugar()
 }
 
 func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
-	log := FromContext(ctx).
+	// context fields are added by FromContext, they are not saved to the logger
+	// so that they are not duplicated and trace fields follow the current span
+	log := getLogger(ctx).
 		Desugar().
 		With(fields...).
 		Sugar()
 	return ToContext(ctx, log)
 }
 
+// WithRequestID - returns ctx whose log lines include request_id
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	f := getContextFields(ctx)
+	f.requestID = requestID
+	return context.WithValue(ctx, fieldsContextKey, f)
+}
+
+// WithUserID - returns ctx whose log lines include user_id
+func WithUserID(ctx context.Context, userID uint64) context.Context {
+	f := getContextFields(ctx)
+	f.userID = userID
+	return context.WithValue(ctx, fieldsContextKey, f)
+}
+
+// WithMethod - returns ctx whose log lines include gRPC method
+func WithMethod(ctx context.Context, method string) context.Context {
+	f := getContextFields(ctx)
+	f.method = method
+	return context.WithValue(ctx, fieldsContextKey, f)
+}
+
+// RequestID - returns request id of ctx, empty when there is none
+func RequestID(ctx context.Context) string {
+	return getContextFields(ctx).requestID
+}
+
+func fieldsFromContext(ctx context.Context, f contextFields) []zap.Field {
+	var fields []zap.Field
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", sc.TraceID().String()),
+			zap.String("span_id", sc.SpanID().String()),
+		)
+	}
+
+	if f.requestID != "" {
+		fields = append(fields, zap.String("request_id", f.requestID))
+	}
+	if f.userID != 0 {
+		fields = append(fields, zap.Uint64("user_id", f.userID))
+	}
+	if f.method != "" {
+		fields = append(fields, zap.String("method", f.method))
+	}
+
+	return fields
+}
+
+func getContextFields(ctx context.Context) contextFields {
+	f, _ := ctx.Value(fieldsContextKey).(contextFields)
+	return f
+}
+
 func getLogger(ctx context.Context) *zap.SugaredLogger {
 	if logger, ok := ctx.Value(loggerContextKey).(*zap.SugaredLogger); ok {
 		return logger
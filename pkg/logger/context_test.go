@@ -0,0 +1,105 @@
+//go:build test
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func TestFromContext(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	tests := []struct {
+		name string
+		ctx  func(ctx context.Context) context.Context
+		want map[string]any
+	}{
+		{
+			name: "Test 1. No context fields.",
+			ctx:  func(ctx context.Context) context.Context { return ctx },
+			want: map[string]any{},
+		},
+		{
+			name: "Test 2. All context fields.",
+			ctx: func(ctx context.Context) context.Context {
+				ctx = trace.ContextWithSpanContext(ctx, sc)
+				ctx = WithRequestID(ctx, "req-1")
+				ctx = WithUserID(ctx, 42)
+				return WithMethod(ctx, "/oms.Service/CreateOrder")
+			},
+			want: map[string]any{
+				"trace_id":   "4bf92f3577b34da6a3ce929d0e0e4736",
+				"span_id":    "00f067aa0ba902b7",
+				"request_id": "req-1",
+				"user_id":    float64(42),
+				"method":     "/oms.Service/CreateOrder",
+			},
+		},
+		{
+			name: "Test 3. Fields of WithFields are kept and not duplicated.",
+			ctx: func(ctx context.Context) context.Context {
+				ctx = WithRequestID(ctx, "req-1")
+				return WithFields(ctx, zap.String("job", "cleanup"))
+			},
+			want: map[string]any{
+				"request_id": "req-1",
+				"job":        "cleanup",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sink bytes.Buffer
+			ctx := ToContext(context.Background(), NewWithSink(nil, &sink))
+
+			FromContext(tt.ctx(ctx)).Info("message")
+
+			assert.LessOrEqual(t, strings.Count(sink.String(), `"request_id"`), 1)
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(sink.Bytes(), &got))
+			for _, key := range []string{"ts", "level", "message"} {
+				delete(got, key)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAudit(t *testing.T) {
+	var sink bytes.Buffer
+	SetAuditLogger(NewAudit(&sink))
+	defer SetAuditLogger(NewAudit(os.Stdout))
+
+	prev := Level()
+	SetLevel(zap.ErrorLevel)
+	defer SetLevel(prev)
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	Audit(ctx, "order mutation", "action", "create_order")
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(sink.Bytes(), &got), "audit record is written regardless of level")
+	delete(got, "ts")
+	assert.Equal(t, map[string]any{
+		"level":      "info",
+		"logger":     "audit",
+		"message":    "order mutation",
+		"request_id": "req-1",
+		"action":     "create_order",
+	}, got)
+}
@@ -0,0 +1,101 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// DebugWindow - debug logging of requests to given gRPC methods or on behalf of given users
+// regardless of the global level until the window expires
+type DebugWindow struct {
+	Methods []string
+	UserIDs []uint64
+	Until   time.Time
+}
+
+var debugWindow atomic.Pointer[DebugWindow]
+
+// SetDebugWindow - replaces debug window, zero window disables it
+func SetDebugWindow(w DebugWindow) {
+	debugWindow.Store(&w)
+}
+
+// GetDebugWindow - returns current debug window, zero when there is none or it is expired
+func GetDebugWindow() DebugWindow {
+	w := debugWindow.Load()
+	if w == nil || !time.Now().Before(w.Until) {
+		return DebugWindow{}
+	}
+	return *w
+}
+
+// matches - reports whether request of ctx is in debug window
+func (w DebugWindow) matches(f contextFields) bool {
+	if w.Until.IsZero() {
+		return false
+	}
+	return (f.method != "" && slices.Contains(w.Methods, f.method)) ||
+		(f.userID != 0 && slices.Contains(w.UserIDs, f.userID))
+}
+
+// ToggleDebugOnSignal - switches global level to debug and back to the previous one on every sig
+func ToggleDebugOnSignal(ctx context.Context, sig os.Signal) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+
+	go func() {
+		defer signal.Stop(ch)
+
+		previous := Level()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				level := zapcore.DebugLevel
+				if Level() == zapcore.DebugLevel {
+					level = previous
+				} else {
+					previous = Level()
+				}
+				SetLevel(level)
+				Logger().Infow("log level is changed by signal", "level", level.String(), "signal", sig.String())
+			}
+		}
+	}()
+}
+
+// debugCore - core enabling debug level regardless of the level of wrapped core
+type debugCore struct {
+	zapcore.Core
+}
+
+func withDebugLevel(l *zap.Logger) *zap.Logger {
+	return l.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return debugCore{Core: c}
+	}))
+}
+
+func (c debugCore) Enabled(zapcore.Level) bool {
+	return true
+}
+
+func (c debugCore) Level() zapcore.Level {
+	return zapcore.DebugLevel
+}
+
+func (c debugCore) With(fields []zapcore.Field) zapcore.Core {
+	return debugCore{Core: c.Core.With(fields)}
+}
+
+func (c debugCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	// wrapped core filters by its level in Check, Write does not
+	return ce.AddCore(ent, c)
+}
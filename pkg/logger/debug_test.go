@@ -0,0 +1,63 @@
+//go:build test
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestDebugWindow(t *testing.T) {
+	defer SetDebugWindow(DebugWindow{})
+
+	tests := []struct {
+		name   string
+		window DebugWindow
+		ctx    func(ctx context.Context) context.Context
+		want   bool
+	}{
+		{
+			name:   "Test 1. Method in window.",
+			window: DebugWindow{Methods: []string{"/oms.Service/CreateOrder"}, Until: time.Now().Add(time.Minute)},
+			ctx:    func(ctx context.Context) context.Context { return WithMethod(ctx, "/oms.Service/CreateOrder") },
+			want:   true,
+		},
+		{
+			name:   "Test 2. User in window.",
+			window: DebugWindow{UserIDs: []uint64{42}, Until: time.Now().Add(time.Minute)},
+			ctx:    func(ctx context.Context) context.Context { return WithUserID(ctx, 42) },
+			want:   true,
+		},
+		{
+			name:   "Test 3. Request out of window.",
+			window: DebugWindow{UserIDs: []uint64{42}, Until: time.Now().Add(time.Minute)},
+			ctx:    func(ctx context.Context) context.Context { return WithUserID(ctx, 7) },
+			want:   false,
+		},
+		{
+			name:   "Test 4. Window is expired.",
+			window: DebugWindow{UserIDs: []uint64{42}, Until: time.Now().Add(-time.Minute)},
+			ctx:    func(ctx context.Context) context.Context { return WithUserID(ctx, 42) },
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sink bytes.Buffer
+			ctx := ToContext(context.Background(), NewWithSink(zap.NewAtomicLevelAt(zap.InfoLevel), &sink))
+			ctx = WithFields(tt.ctx(ctx), zap.String("component", "test"))
+
+			SetDebugWindow(tt.window)
+			Debug(ctx, "debug message")
+			Info(ctx, "info message")
+
+			assert.Equal(t, tt.want, bytes.Contains(sink.Bytes(), []byte("debug message")))
+			assert.Contains(t, sink.String(), "info message")
+		})
+	}
+}
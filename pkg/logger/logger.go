@@ -11,6 +11,7 @@ import (
 
 var (
 	global       *zap.SugaredLogger
+	audit        *zap.SugaredLogger
 	defaultLevel = zap.NewAtomicLevelAt(zap.InfoLevel)
 )
 
@@ -18,6 +19,7 @@ func init() {
 	SetLogger(New(defaultLevel,
 		zap.AddStacktrace(zap.FatalLevel),
 	))
+	SetAuditLogger(NewAudit(os.Stdout))
 }
 
 func New(level zapcore.LevelEnabler, options ...zap.Option) *zap.SugaredLogger {
@@ -70,6 +72,16 @@ func SetLogger(l *zap.SugaredLogger) {
 	global = l
 }
 
+// NewAudit - returns audit logger writing to sink, it is not affected by log level
+func NewAudit(sink io.Writer) *zap.SugaredLogger {
+	return NewWithSink(zapcore.DebugLevel, sink).Named("audit")
+}
+
+// SetAuditLogger - sets logger of Audit, e.g. with dedicated sink
+func SetAuditLogger(l *zap.SugaredLogger) {
+	audit = l
+}
+
 func Debug(ctx context.Context, args ...interface{}) {
 	if logger := FromContext(ctx); logger.Level().Enabled(zapcore.DebugLevel) {
 		logger.Debug(args...)
@@ -166,6 +178,11 @@ func PanicKV(ctx context.Context, message string, kvs ...interface{}) {
 	FromContext(ctx).Panicw(message, kvs...)
 }
 
+// Audit - writes audit record to audit logger with trace_id, span_id, request_id, user_id and method of ctx,
+// records are written regardless of log level
 func Audit(ctx context.Context, message string, kvs ...interface{}) {
-	FromContext(ctx).Errorw(message, kvs...)
+	audit.Desugar().
+		With(fieldsFromContext(ctx, getContextFields(ctx))...).
+		Sugar().
+		Infow(message, kvs...)
 }
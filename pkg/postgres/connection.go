@@ -10,8 +10,6 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/log"
 	pgxUUID "github.com/vgarvardt/pgx-google-uuid/v5"
 )
 
@@ -140,6 +138,15 @@ func (c *Connection) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (*Tra
 	return &Transaction{tx}, nil
 }
 
+// Acquire - returns dedicated connection from pool, it must be released after use
+func (c *Connection) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	conn, err := c.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: %w", err)
+	}
+	return conn, nil
+}
+
 func (c *Connection) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
 	return c.pool.SendBatch(ctx, b)
 }
@@ -159,15 +166,12 @@ func (c *Connection) Getx(ctx context.Context, dest interface{}, sqlizer Sqlizer
 		return fmt.Errorf("postgres: to sql: %w", err)
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "postgres.Getx")
-	defer span.Finish()
+	ctx, span := startSpan(ctx, "postgres.Getx", query)
 
-	span.LogFields(
-		log.String("query", query),
-		log.Object("args", args),
-	)
+	err = pgxscan.Get(ctx, c.pool, dest, query, args...)
+	endSpan(span, err)
 
-	return pgxscan.Get(ctx, c.pool, dest, query, args...)
+	return err
 }
 
 // Selectx - aka Query
@@ -177,15 +181,12 @@ func (c *Connection) Selectx(ctx context.Context, dest interface{}, sqlizer Sqli
 		return fmt.Errorf("postgres: to sql: %w", err)
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "postgres.Selectx")
-	defer span.Finish()
+	ctx, span := startSpan(ctx, "postgres.Selectx", query)
 
-	span.LogFields(
-		log.String("query", query),
-		log.Object("args", args),
-	)
+	err = pgxscan.Select(ctx, c.pool, dest, query, args...)
+	endSpan(span, err)
 
-	return pgxscan.Select(ctx, c.pool, dest, query, args...)
+	return err
 }
 
 // Execx - aka Exec
@@ -195,13 +196,10 @@ func (c *Connection) Execx(ctx context.Context, sqlizer Sqlizer) (pgconn.Command
 		return pgconn.CommandTag{}, fmt.Errorf("postgres: to sql: %w", err)
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "postgres.Execx")
-	defer span.Finish()
+	ctx, span := startSpan(ctx, "postgres.Execx", query)
 
-	span.LogFields(
-		log.String("query", query),
-		log.Object("args", args),
-	)
+	tag, err := c.pool.Exec(ctx, query, args...)
+	endSpan(span, err)
 
-	return c.pool.Exec(ctx, query, args...)
+	return tag, err
 }
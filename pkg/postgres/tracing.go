@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan - starts client span of query, arguments are not recorded as they may contain personal data
+func startSpan(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+// endSpan - ends span, no rows is an expected outcome and is not recorded as error
+func endSpan(span trace.Span, err error) {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		tracing.RecordError(span, err)
+	}
+	span.End()
+}
@@ -1,9 +1,59 @@
 package postgres
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type Transaction struct {
 	pgx.Tx
 }
+
+// Getx - aka QueryRow
+func (t *Transaction) Getx(ctx context.Context, dest interface{}, sqlizer Sqlizer) error {
+	query, args, err := sqlizer.ToSql()
+	if err != nil {
+		return fmt.Errorf("postgres: to sql: %w", err)
+	}
+
+	ctx, span := startSpan(ctx, "postgres.Transaction.Getx", query)
+
+	err = pgxscan.Get(ctx, t.Tx, dest, query, args...)
+	endSpan(span, err)
+
+	return err
+}
+
+// Selectx - aka Query
+func (t *Transaction) Selectx(ctx context.Context, dest interface{}, sqlizer Sqlizer) error {
+	query, args, err := sqlizer.ToSql()
+	if err != nil {
+		return fmt.Errorf("postgres: to sql: %w", err)
+	}
+
+	ctx, span := startSpan(ctx, "postgres.Transaction.Selectx", query)
+
+	err = pgxscan.Select(ctx, t.Tx, dest, query, args...)
+	endSpan(span, err)
+
+	return err
+}
+
+// Execx - aka Exec
+func (t *Transaction) Execx(ctx context.Context, sqlizer Sqlizer) (pgconn.CommandTag, error) {
+	query, args, err := sqlizer.ToSql()
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("postgres: to sql: %w", err)
+	}
+
+	ctx, span := startSpan(ctx, "postgres.Transaction.Execx", query)
+
+	tag, err := t.Tx.Exec(ctx, query, args...)
+	endSpan(span, err)
+
+	return tag, err
+}
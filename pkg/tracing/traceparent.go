@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	traceParentHeader = "traceparent"
+	traceStateHeader  = "tracestate"
+)
+
+// TraceContext - returns W3C traceparent and tracestate of the span in ctx, empty when there is no span
+func TraceContext(ctx context.Context) (traceParent, traceState string) {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	return carrier.Get(traceParentHeader), carrier.Get(traceStateHeader)
+}
+
+// ContextWithTraceContext - returns ctx with remote span context of W3C traceparent and tracestate,
+// ctx is returned as is when traceparent is empty or invalid
+func ContextWithTraceContext(ctx context.Context, traceParent, traceState string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{
+		traceParentHeader: traceParent,
+		traceStateHeader:  traceState,
+	})
+}
@@ -2,31 +2,153 @@ package tracing
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/moguchev/microservices_courcse/orders_management_system/pkg/closer"
-	"github.com/uber/jaeger-client-go/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func Init(serviceName string) error {
-	cfg := config.Configuration{
-		Sampler: &config.SamplerConfig{
-			Type:  "const",
-			Param: 1,
-		},
-		Reporter: &config.ReporterConfig{
-			LocalAgentHostPort: os.Getenv("JAEGER_HOST"),
-		},
+// instrumentationName - name of the tracer used by the service code
+const instrumentationName = "github.com/moguchev/microservices_courcse/orders_management_system"
+
+// Exporter - where finished spans are sent
+type Exporter string
+
+const (
+	// ExporterOTLPGRPC - OTLP over gRPC, default port 4317
+	ExporterOTLPGRPC Exporter = "otlp-grpc"
+	// ExporterOTLPHTTP - OTLP over HTTP, default port 4318
+	ExporterOTLPHTTP Exporter = "otlp-http"
+	// ExporterStdout - pretty printed spans in stdout, for local debugging
+	ExporterStdout Exporter = "stdout"
+	// ExporterNone - spans are sampled and propagated but not exported
+	ExporterNone Exporter = "none"
+)
+
+type Config struct {
+	ServiceName    string
+	ServiceVersion string
+	// Environment - deployment.environment resource attribute
+	Environment string
+
+	Exporter Exporter
+	// Endpoint - host:port of collector, exporter default (or OTEL_EXPORTER_OTLP_* env) when empty
+	Endpoint string
+	// Insecure - disables TLS to collector
+	Insecure bool
+
+	// SampleRatio - share of root traces sampled in [0, 1],
+	// traces started by callers follow their sampling decision
+	SampleRatio float64
+}
+
+// Init - sets up global tracer provider and W3C trace context propagator, provider is flushed on close
+func Init(ctx context.Context, cfg Config) error {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return fmt.Errorf("tracing: sample ratio %v is out of [0, 1]", cfg.SampleRatio)
 	}
 
-	close, err := cfg.InitGlobalTracer(serviceName)
+	res, err := newResource(ctx, cfg)
 	if err != nil {
-		return err
+		return fmt.Errorf("tracing: resource: %w", err)
 	}
 
-	closer.Add(func(ctx context.Context) error {
-		return close.Close()
-	})
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("tracing: exporter: %w", err)
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	closer.Add(provider.Shutdown)
 
 	return nil
 }
+
+// Start - starts span with the service tracer
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+func newResource(ctx context.Context, cfg Config) (*resource.Resource, error) {
+	attrs := []resource.Option{
+		resource.WithFromEnv(), // OTEL_RESOURCE_ATTRIBUTES, OTEL_SERVICE_NAME
+		resource.WithHost(),
+		resource.WithProcessPID(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(cfg.ServiceName)),
+	}
+	if cfg.ServiceVersion != "" {
+		attrs = append(attrs, resource.WithAttributes(semconv.ServiceVersion(cfg.ServiceVersion)))
+	}
+	if cfg.Environment != "" {
+		attrs = append(attrs, resource.WithAttributes(semconv.DeploymentEnvironment(cfg.Environment)))
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, resource.WithAttributes(semconv.ServiceInstanceID(hostname)))
+	}
+
+	return resource.New(ctx, attrs...)
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOTLPGRPC, "":
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", cfg.Exporter)
+	}
+}
+
+// RecordError - marks span as failed, nil err is ignored
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
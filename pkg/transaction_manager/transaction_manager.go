@@ -16,12 +16,21 @@ func New(connection *postgres.Connection) *TransactionManager {
 	return &TransactionManager{connection: connection}
 }
 
+// Check that transaction is usable as query engine
+var _ QueryEngine = (*postgres.Transaction)(nil)
+
 type key string
 
 const (
-	txKey key = "tx"
+	txKey          key = "tx"
+	afterCommitKey key = "after_commit"
 )
 
+// afterCommitHooks - functions run after commit of the outermost transaction
+type afterCommitHooks struct {
+	fns []func()
+}
+
 func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOptions, fn func(ctx context.Context) error) (err error) {
 	tx, ok := ctx.Value(txKey).(*postgres.Transaction)
 	if ok {
@@ -34,7 +43,9 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 	}
 
 	tx = &postgres.Transaction{Tx: pgxTx}
+	hooks := &afterCommitHooks{}
 	ctx = context.WithValue(ctx, txKey, tx)
+	ctx = context.WithValue(ctx, afterCommitKey, hooks)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -52,6 +63,11 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 			if errRollback := tx.Rollback(ctx); errRollback != nil {
 				err = fmt.Errorf("rollback failed: %v", errRollback)
 			}
+			return
+		}
+
+		for _, fn := range hooks.fns {
+			fn()
 		}
 	}()
 	err = fn(ctx)
@@ -59,6 +75,17 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 	return err
 }
 
+// AfterCommit - runs fn after commit of the transaction in ctx, fn is dropped on rollback.
+// Without transaction fn is run immediately
+func (m *TransactionManager) AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(afterCommitKey).(*afterCommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
+}
+
 func (m *TransactionManager) GetQueryEngine(ctx context.Context) QueryEngine {
 	if tx, ok := ctx.Value(txKey).(QueryEngine); ok {
 		return tx